@@ -0,0 +1,268 @@
+package ownSdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type ActionType string
+
+const (
+	ActionTypeTransferChx                ActionType = "TransferChx"
+	ActionTypeDelegateStake              ActionType = "DelegateStake"
+	ActionTypeConfigureValidator         ActionType = "ConfigureValidator"
+	ActionTypeRemoveValidator            ActionType = "RemoveValidator"
+	ActionTypeTransferAsset              ActionType = "TransferAsset"
+	ActionTypeCreateAssetEmission        ActionType = "CreateAssetEmission"
+	ActionTypeCreateAsset                ActionType = "CreateAsset"
+	ActionTypeSetAssetCode               ActionType = "SetAssetCode"
+	ActionTypeSetAssetController         ActionType = "SetAssetController"
+	ActionTypeCreateAccount              ActionType = "CreateAccount"
+	ActionTypeSetAccountController       ActionType = "SetAccountController"
+	ActionTypeSubmitVote                 ActionType = "SubmitVote"
+	ActionTypeSubmitVoteWeight           ActionType = "SubmitVoteWeight"
+	ActionTypeSetAccountEligibility      ActionType = "SetAccountEligibility"
+	ActionTypeSetAssetEligibility        ActionType = "SetAssetEligibility"
+	ActionTypeChangeKycControllerAddress ActionType = "ChangeKycControllerAddress"
+	ActionTypeAddKycProvider             ActionType = "AddKycProvider"
+	ActionTypeRemoveKycProvider          ActionType = "RemoveKycProvider"
+)
+
+// ActionData is implemented by the DTO of every action type. The DTO is marshalled as the
+// "actionData" of the action.
+type ActionData interface {
+	ActionType() ActionType
+}
+
+// ValidatableActionData can be implemented by DTOs of action types registered with
+// RegisterActionType, to be checked by Tx.Validate.
+type ValidatableActionData interface {
+	ActionData
+	Validate() error
+}
+
+// RawActionData holds the data of an action whose type is not registered, e.g. an action added to
+// the node after this SDK version. It is marshalled back unchanged.
+type RawActionData struct {
+	Type ActionType
+	Data json.RawMessage
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Action Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (TransferChxTxActionDto) ActionType() ActionType {
+	return ActionTypeTransferChx
+}
+
+func (DelegateStakeTxActionDto) ActionType() ActionType {
+	return ActionTypeDelegateStake
+}
+
+func (ConfigureValidatorTxActionDto) ActionType() ActionType {
+	return ActionTypeConfigureValidator
+}
+
+func (RemoveValidatorTxActionDto) ActionType() ActionType {
+	return ActionTypeRemoveValidator
+}
+
+func (TransferAssetTxActionDto) ActionType() ActionType {
+	return ActionTypeTransferAsset
+}
+
+func (CreateAssetEmissionTxActionDto) ActionType() ActionType {
+	return ActionTypeCreateAssetEmission
+}
+
+func (CreateAssetTxActionDto) ActionType() ActionType {
+	return ActionTypeCreateAsset
+}
+
+func (SetAssetCodeTxActionDto) ActionType() ActionType {
+	return ActionTypeSetAssetCode
+}
+
+func (SetAssetControllerTxActionDto) ActionType() ActionType {
+	return ActionTypeSetAssetController
+}
+
+func (CreateAccountTxActionDto) ActionType() ActionType {
+	return ActionTypeCreateAccount
+}
+
+func (SetAccountControllerTxActionDto) ActionType() ActionType {
+	return ActionTypeSetAccountController
+}
+
+func (SubmitVoteTxActionDto) ActionType() ActionType {
+	return ActionTypeSubmitVote
+}
+
+func (SubmitVoteWeightTxActionDto) ActionType() ActionType {
+	return ActionTypeSubmitVoteWeight
+}
+
+func (SetAccountEligibilityTxActionDto) ActionType() ActionType {
+	return ActionTypeSetAccountEligibility
+}
+
+func (SetAssetEligibilityTxActionDto) ActionType() ActionType {
+	return ActionTypeSetAssetEligibility
+}
+
+func (ChangeKycControllerAddressTxActionDto) ActionType() ActionType {
+	return ActionTypeChangeKycControllerAddress
+}
+
+func (AddKycProviderTxActionDto) ActionType() ActionType {
+	return ActionTypeAddKycProvider
+}
+
+func (RemoveKycProviderTxActionDto) ActionType() ActionType {
+	return ActionTypeRemoveKycProvider
+}
+
+func (d RawActionData) ActionType() ActionType {
+	return d.Type
+}
+
+func (d RawActionData) MarshalJSON() ([]byte, error) {
+	if len(d.Data) == 0 {
+		return []byte("null"), nil
+	}
+	return d.Data, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Registry
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	actionTypesMutex sync.RWMutex
+	actionTypes      = map[ActionType]reflect.Type{}
+)
+
+func init() {
+	for _, data := range []ActionData{
+		TransferChxTxActionDto{},
+		DelegateStakeTxActionDto{},
+		ConfigureValidatorTxActionDto{},
+		RemoveValidatorTxActionDto{},
+		TransferAssetTxActionDto{},
+		CreateAssetEmissionTxActionDto{},
+		CreateAssetTxActionDto{},
+		SetAssetCodeTxActionDto{},
+		SetAssetControllerTxActionDto{},
+		CreateAccountTxActionDto{},
+		SetAccountControllerTxActionDto{},
+		SubmitVoteTxActionDto{},
+		SubmitVoteWeightTxActionDto{},
+		SetAccountEligibilityTxActionDto{},
+		SetAssetEligibilityTxActionDto{},
+		ChangeKycControllerAddressTxActionDto{},
+		AddKycProviderTxActionDto{},
+		RemoveKycProviderTxActionDto{},
+	} {
+		RegisterActionType(data)
+	}
+}
+
+// RegisterActionType makes transactions with actions of data.ActionType() unmarshal into the
+// type of data, which must be a struct value. It is meant to be called from an init function
+// for action types the node adds after this SDK version, and panics if the type is already
+// registered.
+func RegisterActionType(data ActionData) {
+	dataType := reflect.TypeOf(data)
+	if dataType == nil || dataType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ownSdk: action data must be a struct value, got %v", dataType))
+	}
+	if dataType == reflect.TypeOf(RawActionData{}) {
+		panic("ownSdk: RawActionData can't be registered")
+	}
+
+	actionType := data.ActionType()
+	actionTypesMutex.Lock()
+	defer actionTypesMutex.Unlock()
+	if _, ok := actionTypes[actionType]; ok {
+		panic(fmt.Sprintf("ownSdk: action type %q is already registered", actionType))
+	}
+	actionTypes[actionType] = dataType
+}
+
+func actionDataType(actionType ActionType) (reflect.Type, bool) {
+	actionTypesMutex.RLock()
+	defer actionTypesMutex.RUnlock()
+	dataType, ok := actionTypes[actionType]
+	return dataType, ok
+}
+
+func IsRegisteredActionType(actionType ActionType) bool {
+	_, ok := actionDataType(actionType)
+	return ok
+}
+
+// RegisteredActionTypes returns all registered action types, sorted.
+func RegisteredActionTypes() []ActionType {
+	actionTypesMutex.RLock()
+	defer actionTypesMutex.RUnlock()
+	result := make([]ActionType, 0, len(actionTypes))
+	for actionType := range actionTypes {
+		result = append(result, actionType)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+// NewActionData returns a pointer to a new zero DTO of the registered action type.
+func NewActionData(actionType ActionType) (ActionData, error) {
+	dataType, ok := actionDataType(actionType)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownActionType, actionType)
+	}
+	return reflect.New(dataType).Interface().(ActionData), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// JSON
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// UnmarshalJSON decodes the action data into the DTO registered for the action type, or into
+// RawActionData if the type is not registered.
+func (a *TxAction) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ActionType ActionType      `json:"actionType"`
+		ActionData json.RawMessage `json:"actionData"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	dataType, ok := actionDataType(raw.ActionType)
+	if !ok {
+		rawData := RawActionData{Type: raw.ActionType}
+		if len(raw.ActionData) > 0 && !bytes.Equal(raw.ActionData, []byte("null")) {
+			rawData.Data = append(json.RawMessage(nil), raw.ActionData...)
+		}
+		*a = TxAction{ActionType: raw.ActionType, ActionData: rawData}
+		return nil
+	}
+
+	dto := reflect.New(dataType)
+	if len(raw.ActionData) > 0 {
+		if err := json.Unmarshal(raw.ActionData, dto.Interface()); err != nil {
+			return fmt.Errorf("%w: %s action: %v", ErrInvalidTx, raw.ActionType, err)
+		}
+	}
+	*a = TxAction{ActionType: raw.ActionType, ActionData: dto.Elem().Interface().(ActionData)}
+	return nil
+}
@@ -0,0 +1,185 @@
+package ownSdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mintNftTestActionDto struct {
+	CollectionHash string `json:"collectionHash"`
+	TokenId        int64  `json:"tokenId"`
+}
+
+func (mintNftTestActionDto) ActionType() ActionType {
+	return "TestMintNft"
+}
+
+type burnNftTestActionDto struct {
+	TokenId int64 `json:"tokenId"`
+}
+
+func (burnNftTestActionDto) ActionType() ActionType {
+	return "TestBurnNft"
+}
+
+func (d burnNftTestActionDto) Validate() error {
+	if d.TokenId <= 0 {
+		return fmt.Errorf("tokenId must be greater than zero, got %d", d.TokenId)
+	}
+	return nil
+}
+
+func init() {
+	RegisterActionType(mintNftTestActionDto{})
+	RegisterActionType(burnNftTestActionDto{})
+}
+
+func newAllActionsTestTx() *Tx {
+	tx := CreateTx("CHxxx", 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction("CHa", MustParseAmount("1.5"))
+	tx.AddDelegateStakeAction("CHv", MustParseAmount("-2"))
+	tx.AddConfigureValidatorAction("val01:25718", 10, true)
+	tx.AddRemoveValidatorAction()
+	tx.AddTransferAssetAction("AH1", "AH2", "AS1", MustParseAmount("3"))
+	tx.AddCreateAssetEmissionAction("AH1", "AS1", MustParseAmount("4"))
+	tx.AddCreateAssetAction()
+	tx.AddSetAssetCodeAction("AS1", "OWN1")
+	tx.AddSetAssetControllerAction("AS1", "CHc")
+	tx.AddCreateAccountAction()
+	tx.AddSetAccountControllerAction("AH1", "CHc")
+	tx.AddSubmitVoteAction("AH1", "AS1", "RSH1", "Yes")
+	tx.AddSubmitVoteWeightAction("AH1", "AS1", "RSH1", MustParseAmount("5"))
+	tx.AddSetAccountEligibilityAction("AH1", "AS1", true, false)
+	tx.AddSetAssetEligibilityAction("AS1", true)
+	tx.AddChangeKycControllerAddressAction("AH1", "AS1", "CHk")
+	tx.AddAddKycProviderAction("AS1", "CHp")
+	tx.AddRemoveKycProviderAction("AS1", "CHp")
+	return tx
+}
+
+func TestBuiltInActionTypesAreRegistered(t *testing.T) {
+	tx := newAllActionsTestTx()
+	assert.Len(t, tx.Actions, 18)
+
+	registered := RegisteredActionTypes()
+	for _, action := range tx.Actions {
+		assert.True(t, IsRegisteredActionType(action.ActionType), action.ActionType)
+		assert.Contains(t, registered, action.ActionType)
+		assert.Equal(t, action.ActionType, action.ActionData.ActionType())
+	}
+	assert.False(t, IsRegisteredActionType("MintChx"))
+}
+
+// nodeActionTypes is the list of action types accepted by the node's submitTx API.
+var nodeActionTypes = []ActionType{
+	"TransferChx",
+	"DelegateStake",
+	"ConfigureValidator",
+	"RemoveValidator",
+	"TransferAsset",
+	"CreateAssetEmission",
+	"CreateAsset",
+	"SetAssetCode",
+	"SetAssetController",
+	"CreateAccount",
+	"SetAccountController",
+	"SubmitVote",
+	"SubmitVoteWeight",
+	"SetAccountEligibility",
+	"SetAssetEligibility",
+	"ChangeKycControllerAddress",
+	"AddKycProvider",
+	"RemoveKycProvider",
+}
+
+func TestAllNodeActionTypesHaveBuilders(t *testing.T) {
+	built := make([]ActionType, 0)
+	for _, action := range newAllActionsTestTx().Actions {
+		built = append(built, action.ActionType)
+	}
+	assert.Equal(t, nodeActionTypes, built)
+
+	registered := RegisteredActionTypes()
+	for _, actionType := range nodeActionTypes {
+		assert.Contains(t, registered, actionType)
+	}
+}
+
+func TestTxJsonRoundTripProducesConcreteDtos(t *testing.T) {
+	tx := newAllActionsTestTx()
+
+	var unmarshalled Tx
+	assert.NoError(t, json.Unmarshal([]byte(tx.ToJson(false)), &unmarshalled))
+
+	assert.Equal(t, tx, &unmarshalled)
+	assert.Equal(t, tx.ToJson(false), unmarshalled.ToJson(false))
+	assert.Equal(t, tx.TxHash(), unmarshalled.TxHash())
+}
+
+func TestTxActionUnmarshalUnknownActionType(t *testing.T) {
+	actionJson := `{"actionType":"MintChx","actionData":{"amount":5,"note":"x"}}`
+
+	var action TxAction
+	assert.NoError(t, json.Unmarshal([]byte(actionJson), &action))
+
+	assert.Equal(t, ActionType("MintChx"), action.ActionType)
+	assert.Equal(t, RawActionData{Type: "MintChx", Data: json.RawMessage(`{"amount":5,"note":"x"}`)}, action.ActionData)
+	assert.Equal(t, actionJson, toJson(action, false))
+
+	_, err := ParseTx(`{"senderAddress":"CHxxx","nonce":1,"actionFee":0.01,"actions":[` + actionJson + `]}`)
+	assert.ErrorIs(t, err, ErrUnknownActionType)
+}
+
+func TestTxActionUnmarshalInvalidData(t *testing.T) {
+	var action TxAction
+	err := json.Unmarshal([]byte(`{"actionType":"TransferChx","actionData":{"amount":"abc"}}`), &action)
+	assert.ErrorIs(t, err, ErrInvalidTx)
+
+	_, err = ParseTx(`{"actions":[{"actionType":"TransferChx","actionData":{"amount":"abc"}}]}`)
+	assert.ErrorIs(t, err, ErrInvalidTx)
+}
+
+func TestRegisteredActionType(t *testing.T) {
+	tx := CreateTx("CHxxx", 1, MustParseAmount("0.01"), 0)
+	tx.AddAction(mintNftTestActionDto{CollectionHash: "CH1", TokenId: 7})
+
+	assert.Equal(t,
+		`{"senderAddress":"CHxxx","nonce":1,"expirationTime":0,"actionFee":0.01,"actions":[{"actionType":"TestMintNft","actionData":{"collectionHash":"CH1","tokenId":7}}]}`,
+		tx.ToJson(false))
+
+	parsedTx, err := ParseTx(tx.ToJson(false))
+	assert.NoError(t, err)
+	assert.Equal(t, tx, parsedTx)
+
+	data, err := NewActionData("TestMintNft")
+	assert.NoError(t, err)
+	assert.IsType(t, &mintNftTestActionDto{}, data)
+
+	_, err = NewActionData("MintChx")
+	assert.ErrorIs(t, err, ErrUnknownActionType)
+}
+
+func TestRegisterActionTypeInvalid(t *testing.T) {
+	assert.Panics(t, func() { RegisterActionType(mintNftTestActionDto{}) })
+	assert.Panics(t, func() { RegisterActionType(TransferChxTxActionDto{}) })
+	assert.Panics(t, func() { RegisterActionType(&mintNftTestActionDto{}) })
+	assert.Panics(t, func() { RegisterActionType(RawActionData{Type: "MintChx"}) })
+}
+
+func TestValidateRegisteredActionType(t *testing.T) {
+	tx := CreateTx(GenerateWallet().Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddAction(mintNftTestActionDto{CollectionHash: "CH1", TokenId: 7})
+	tx.AddAction(&burnNftTestActionDto{TokenId: 7})
+	assert.NoError(t, tx.Validate())
+
+	tx.AddAction(burnNftTestActionDto{TokenId: 0})
+	errs := validationErrors(t, tx.Validate())
+	assert.Equal(t, []TxFieldError{{
+		ActionIndex: 2,
+		ActionType:  "TestBurnNft",
+		Message:     "tokenId must be greater than zero, got 0",
+	}}, errs)
+}
@@ -0,0 +1,189 @@
+package ownSdk
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Amount is an exact decimal value with 7 decimal places, which is the precision of CHX and assets
+// on Own blockchain. The zero value is 0.
+type Amount struct {
+	units int64
+}
+
+const AmountDecimals = 7
+
+const amountScale int64 = 10000000
+
+var (
+	MaxAmount = Amount{units: 999999999999999999} // 99999999999.9999999
+	MinAmount = Amount{units: -999999999999999999}
+)
+
+var (
+	ErrInvalidAmount    = errors.New("invalid amount")
+	ErrAmountPrecision  = errors.New("amount has more than 7 decimal places")
+	ErrAmountOutOfRange = errors.New("amount out of range")
+)
+
+var amountPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d{1,3})?$`)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructors
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func ParseAmount(s string) (Amount, error) {
+	if !amountPattern.MatchString(s) {
+		return Amount{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	value, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Amount{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	value.Mul(value, new(big.Rat).SetInt64(amountScale))
+	if !value.IsInt() {
+		return Amount{}, fmt.Errorf("%w: %q", ErrAmountPrecision, s)
+	}
+
+	units := value.Num()
+	if !units.IsInt64() {
+		return Amount{}, fmt.Errorf("%w: %q", ErrAmountOutOfRange, s)
+	}
+
+	return AmountFromUnits(units.Int64())
+}
+
+func MustParseAmount(s string) Amount {
+	amount, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func AmountFromInt(value int64) (Amount, error) {
+	if value > MaxAmount.units/amountScale || value < MinAmount.units/amountScale {
+		return Amount{}, fmt.Errorf("%w: %d", ErrAmountOutOfRange, value)
+	}
+	return Amount{units: value * amountScale}, nil
+}
+
+// AmountFromUnits creates an amount from a number of the smallest units (0.0000001).
+func AmountFromUnits(units int64) (Amount, error) {
+	if units > MaxAmount.units || units < MinAmount.units {
+		return Amount{}, fmt.Errorf("%w: %d units", ErrAmountOutOfRange, units)
+	}
+	return Amount{units: units}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Arithmetic
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (a Amount) Units() int64 {
+	return a.units
+}
+
+func (a Amount) IsZero() bool {
+	return a.units == 0
+}
+
+func (a Amount) IsPositive() bool {
+	return a.units > 0
+}
+
+func (a Amount) IsNegative() bool {
+	return a.units < 0
+}
+
+func (a Amount) Cmp(b Amount) int {
+	switch {
+	case a.units < b.units:
+		return -1
+	case a.units > b.units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (a Amount) Neg() Amount {
+	return Amount{units: -a.units}
+}
+
+func (a Amount) Abs() Amount {
+	if a.units < 0 {
+		return a.Neg()
+	}
+	return a
+}
+
+func (a Amount) Add(b Amount) (Amount, error) {
+	// Both operands are within range, so the sum cannot overflow int64.
+	return AmountFromUnits(a.units + b.units)
+}
+
+func (a Amount) Sub(b Amount) (Amount, error) {
+	return AmountFromUnits(a.units - b.units)
+}
+
+func (a Amount) Mul(n int64) (Amount, error) {
+	product := new(big.Int).Mul(big.NewInt(a.units), big.NewInt(n))
+	if !product.IsInt64() {
+		return Amount{}, fmt.Errorf("%w: %s * %d", ErrAmountOutOfRange, a, n)
+	}
+	return AmountFromUnits(product.Int64())
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Formatting
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (a Amount) String() string {
+	units := a.units
+	sign := ""
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+
+	whole := strconv.FormatInt(units/amountScale, 10)
+	fraction := units % amountScale
+	if fraction == 0 {
+		return sign + whole
+	}
+
+	fractionDigits := fmt.Sprintf("%07d", fraction)
+	return sign + whole + "." + strings.TrimRight(fractionDigits, "0")
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	amount, err := ParseAmount(s)
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
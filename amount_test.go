@@ -0,0 +1,144 @@
+package ownSdk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Parsing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestParseAmount(t *testing.T) {
+	inlineData := map[string]int64{
+		"0":                   0,
+		"1":                   10000000,
+		"0.01":                100000,
+		"0.0000001":           1,
+		"-12.5":               -125000000,
+		"+3":                  30000000,
+		".5":                  5000000,
+		"1.10000000":          11000000,
+		"1e3":                 10000000000,
+		"99999999999.9999999": 999999999999999999,
+	}
+
+	for s, expectedUnits := range inlineData {
+		amount, err := ParseAmount(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expectedUnits, amount.Units(), s)
+	}
+}
+
+func TestParseAmountRejectsInvalidInput(t *testing.T) {
+	inlineData := map[string]error{
+		"":                     ErrInvalidAmount,
+		"abc":                  ErrInvalidAmount,
+		"1/3":                  ErrInvalidAmount,
+		"0x10":                 ErrInvalidAmount,
+		"1,5":                  ErrInvalidAmount,
+		"1e1000":               ErrInvalidAmount,
+		"0.00000001":           ErrAmountPrecision,
+		"0.30000000000000004":  ErrAmountPrecision,
+		"2.5E-7":               ErrAmountPrecision,
+		"100000000000":         ErrAmountOutOfRange,
+		"1e+21":                ErrAmountOutOfRange,
+		"-99999999999.9999999": nil,
+	}
+
+	for s, expectedErr := range inlineData {
+		_, err := ParseAmount(s)
+		if expectedErr == nil {
+			assert.NoError(t, err, s)
+		} else {
+			assert.ErrorIs(t, err, expectedErr, s)
+		}
+	}
+}
+
+func TestAmountFromInt(t *testing.T) {
+	amount, err := AmountFromInt(100)
+	assert.NoError(t, err)
+	assert.Equal(t, "100", amount.String())
+
+	_, err = AmountFromInt(100000000000)
+	assert.ErrorIs(t, err, ErrAmountOutOfRange)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Formatting
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestAmountString(t *testing.T) {
+	inlineData := []string{
+		"0",
+		"1",
+		"0.01",
+		"0.0000001",
+		"-12.5",
+		"1234567.1234567",
+		"99999999999.9999999",
+	}
+
+	for _, s := range inlineData {
+		assert.Equal(t, s, MustParseAmount(s).String())
+	}
+}
+
+func TestAmountJsonRoundtrip(t *testing.T) {
+	dto := TransferChxTxActionDto{
+		RecipientAddress: "CHxxx",
+		Amount:           MustParseAmount("1000000000.1234567"),
+	}
+
+	b, err := json.Marshal(dto)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"recipientAddress":"CHxxx","amount":1000000000.1234567}`, string(b))
+
+	var decoded TransferChxTxActionDto
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, dto, decoded)
+}
+
+func TestAmountUnmarshalJson(t *testing.T) {
+	var amount Amount
+	assert.NoError(t, json.Unmarshal([]byte(`"0.1"`), &amount))
+	assert.Equal(t, MustParseAmount("0.1"), amount)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`0.00000001`), &amount), ErrAmountPrecision)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`1e+21`), &amount), ErrAmountOutOfRange)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Arithmetic
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestAmountArithmetic(t *testing.T) {
+	a := MustParseAmount("0.1")
+	b := MustParseAmount("0.2")
+
+	sum, err := a.Add(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.3", sum.String())
+
+	diff, err := a.Sub(b)
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.1", diff.String())
+	assert.True(t, diff.IsNegative())
+	assert.Equal(t, a, diff.Abs())
+	assert.Equal(t, a, a.Abs())
+
+	product, err := a.Mul(3)
+	assert.NoError(t, err)
+	assert.Equal(t, sum, product)
+	assert.Equal(t, 0, sum.Cmp(product))
+	assert.Equal(t, -1, a.Cmp(b))
+
+	_, err = MaxAmount.Add(MustParseAmount("0.0000001"))
+	assert.ErrorIs(t, err, ErrAmountOutOfRange)
+
+	_, err = MaxAmount.Mul(1000)
+	assert.ErrorIs(t, err, ErrAmountOutOfRange)
+}
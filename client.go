@@ -0,0 +1,363 @@
+package ownSdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type Client struct {
+	BaseUrl    string
+	HttpClient *http.Client
+
+	// TxLifetimePolicy is applied by SubmitTx. Nil means DefaultTxLifetimePolicy().
+	TxLifetimePolicy *TxLifetimePolicy
+}
+
+type ApiError struct {
+	StatusCode int      `json:"-"`
+	Errors     []string `json:"errors"`
+}
+
+type SubmitTxResponseDto struct {
+	TxHash string `json:"txHash"`
+}
+
+type TxInfoDto struct {
+	TxHash                string     `json:"txHash"`
+	SenderAddress         string     `json:"senderAddress"`
+	Nonce                 int64      `json:"nonce"`
+	ExpirationTime        int64      `json:"expirationTime"`
+	ActionFee             Amount     `json:"actionFee"`
+	Actions               []TxAction `json:"actions"`
+	Status                TxStatus   `json:"status"`
+	ErrorCode             *int       `json:"errorCode"`
+	FailedActionNumber    *int       `json:"failedActionNumber"`
+	IncludedInBlockNumber *int64     `json:"includedInBlockNumber"`
+}
+
+type ChxBalanceInfoDto struct {
+	Total     Amount `json:"total"`
+	Staked    Amount `json:"staked"`
+	Deposit   Amount `json:"deposit"`
+	Available Amount `json:"available"`
+}
+
+type AddressInfoDto struct {
+	BlockchainAddress string            `json:"blockchainAddress"`
+	Nonce             int64             `json:"nonce"`
+	Balance           ChxBalanceInfoDto `json:"balance"`
+}
+
+type AddressAccountsDto struct {
+	Accounts []string `json:"accounts"`
+}
+
+type AddressAssetsDto struct {
+	Assets []string `json:"assets"`
+}
+
+type StakeInfoDto struct {
+	ValidatorAddress string `json:"validatorAddress"`
+	Amount           Amount `json:"amount"`
+}
+
+type AddressStakesDto struct {
+	Stakes []StakeInfoDto `json:"stakes"`
+}
+
+type HoldingInfoDto struct {
+	AssetHash string `json:"assetHash"`
+	Balance   Amount `json:"balance"`
+}
+
+type AccountInfoDto struct {
+	AccountHash       string           `json:"accountHash"`
+	ControllerAddress string           `json:"controllerAddress"`
+	Holdings          []HoldingInfoDto `json:"holdings"`
+}
+
+type AssetInfoDto struct {
+	AssetHash             string `json:"assetHash"`
+	AssetCode             string `json:"assetCode"`
+	ControllerAddress     string `json:"controllerAddress"`
+	IsEligibilityRequired bool   `json:"isEligibilityRequired"`
+}
+
+type BlockInfoDto struct {
+	Number                   int64    `json:"number"`
+	Hash                     string   `json:"hash"`
+	PreviousHash             string   `json:"previousHash"`
+	ConfigurationBlockNumber int64    `json:"configurationBlockNumber"`
+	Timestamp                int64    `json:"timestamp"`
+	ProposerAddress          string   `json:"proposerAddress"`
+	TxSetRoot                string   `json:"txSetRoot"`
+	TxResultSetRoot          string   `json:"txResultSetRoot"`
+	StateRoot                string   `json:"stateRoot"`
+	ConsensusRound           int      `json:"consensusRound"`
+	Signatures               []string `json:"signatures"`
+	TxSet                    []string `json:"txSet"`
+}
+
+type ValidatorInfoDto struct {
+	ValidatorAddress    string  `json:"validatorAddress"`
+	NetworkAddress      string  `json:"networkAddress"`
+	SharedRewardPercent float64 `json:"sharedRewardPercent"`
+	IsActive            bool    `json:"isActive"`
+}
+
+type ValidatorsDto struct {
+	Validators []ValidatorInfoDto `json:"validators"`
+}
+
+type ValidatorStakeInfoDto struct {
+	StakerAddress string `json:"stakerAddress"`
+	Amount        Amount `json:"amount"`
+}
+
+type ValidatorStakesDto struct {
+	Stakes []ValidatorStakeInfoDto `json:"stakes"`
+}
+
+type FeeInfoDto struct {
+	MinTxActionFee Amount `json:"minTxActionFee"`
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructor
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func NewClient(baseUrl string) *Client {
+	return NewClientWithHttpClient(baseUrl, http.DefaultClient)
+}
+
+func NewClientWithHttpClient(baseUrl string, httpClient *http.Client) *Client {
+	client := &Client{
+		BaseUrl:    strings.TrimRight(baseUrl, "/"),
+		HttpClient: httpClient,
+	}
+
+	return client
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Errors
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (e *ApiError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("node API request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("node API request failed with status %d: %s", e.StatusCode, strings.Join(e.Errors, "; "))
+}
+
+func IsNotFoundError(err error) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Requests
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseUrl+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := c.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &ApiError{StatusCode: resp.StatusCode}
+		if err := json.Unmarshal(respBody, apiErr); err != nil {
+			// Not the node's error DTO, e.g. a plain text or HTML page from a proxy. The status code
+			// is still reported, along with the body if there is one.
+			apiErr.Errors = nil
+			if text := strings.TrimSpace(string(respBody)); text != "" {
+				apiErr.Errors = []string{text}
+			}
+		}
+		return apiErr
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("cannot decode node API response: %v", err)
+	}
+	return nil
+}
+
+func (c *Client) get(ctx context.Context, path string, result interface{}) error {
+	return c.do(ctx, http.MethodGet, path, nil, result)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Transactions
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *Client) SubmitTx(ctx context.Context, signedTx *SignedTx) (*SubmitTxResponseDto, error) {
+	policy := DefaultTxLifetimePolicy()
+	if c.TxLifetimePolicy != nil {
+		policy = *c.TxLifetimePolicy
+	}
+	if err := policy.checkSignedTx(signedTx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	result := &SubmitTxResponseDto{}
+	if err := c.do(ctx, http.MethodPost, "/tx", signedTx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetTx(ctx context.Context, txHash string) (*TxInfoDto, error) {
+	result := &TxInfoDto{}
+	if err := c.get(ctx, "/tx/"+url.PathEscape(txHash), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Addresses
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *Client) GetAddressInfo(ctx context.Context, address string) (*AddressInfoDto, error) {
+	result := &AddressInfoDto{}
+	if err := c.get(ctx, "/address/"+url.PathEscape(address), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetAddressAccounts(ctx context.Context, address string) (*AddressAccountsDto, error) {
+	result := &AddressAccountsDto{}
+	if err := c.get(ctx, "/address/"+url.PathEscape(address)+"/accounts", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetAddressAssets(ctx context.Context, address string) (*AddressAssetsDto, error) {
+	result := &AddressAssetsDto{}
+	if err := c.get(ctx, "/address/"+url.PathEscape(address)+"/assets", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetAddressStakes(ctx context.Context, address string) (*AddressStakesDto, error) {
+	result := &AddressStakesDto{}
+	if err := c.get(ctx, "/address/"+url.PathEscape(address)+"/stakes", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Accounts and Assets
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *Client) GetAccount(ctx context.Context, accountHash string) (*AccountInfoDto, error) {
+	result := &AccountInfoDto{}
+	if err := c.get(ctx, "/account/"+url.PathEscape(accountHash), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetAsset(ctx context.Context, assetHash string) (*AssetInfoDto, error) {
+	result := &AssetInfoDto{}
+	if err := c.get(ctx, "/asset/"+url.PathEscape(assetHash), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Blocks and Validators
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *Client) GetBlock(ctx context.Context, blockNumber int64) (*BlockInfoDto, error) {
+	result := &BlockInfoDto{}
+	if err := c.get(ctx, "/block/"+strconv.FormatInt(blockNumber, 10), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetValidators(ctx context.Context, activeOnly bool) (*ValidatorsDto, error) {
+	path := "/validators"
+	if activeOnly {
+		path += "?activeOnly=true"
+	}
+
+	result := &ValidatorsDto{}
+	if err := c.get(ctx, path, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetValidatorStakes(ctx context.Context, validatorAddress string) (*ValidatorStakesDto, error) {
+	result := &ValidatorStakesDto{}
+	if err := c.get(ctx, "/validator/"+url.PathEscape(validatorAddress)+"/stakes", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Fees
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *Client) GetFeeInfo(ctx context.Context) (*FeeInfoDto, error) {
+	result := &FeeInfoDto{}
+	if err := c.get(ctx, "/fee", result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
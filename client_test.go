@@ -0,0 +1,222 @@
+package ownSdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNode(t *testing.T, routes map[string]string) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.Method+" "+r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors":["Not found"]}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return NewClient(server.URL + "/")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Transactions
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestClientSubmitTx(t *testing.T) {
+	var received SignedTx
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tx", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		json.NewDecoder(r.Body).Decode(&received)
+		io.WriteString(w, `{"txHash":"TxH1"}`)
+	}))
+	defer server.Close()
+
+	signedTx := &SignedTx{Tx: "dHg=", Signature: "Sig1"}
+	result, err := NewClient(server.URL).SubmitTx(context.Background(), signedTx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "TxH1", result.TxHash)
+	assert.Equal(t, *signedTx, received)
+}
+
+func TestClientSubmitTxReturnsApiError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"errors":["Nonce too low.","Insufficient balance."]}`)
+	}))
+	defer server.Close()
+
+	result, err := NewClient(server.URL).SubmitTx(context.Background(), &SignedTx{})
+
+	assert.Nil(t, result)
+	apiErr, ok := err.(*ApiError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, []string{"Nonce too low.", "Insufficient balance."}, apiErr.Errors)
+	assert.False(t, IsNotFoundError(err))
+}
+
+func TestClientGetTx(t *testing.T) {
+	client := newTestNode(t, map[string]string{
+		"GET /tx/TxH1": `{
+			"txHash": "TxH1",
+			"senderAddress": "CHPJ6aVwpGBRf1dv6Ey1TuhJzt1VtCP5LYB",
+			"nonce": 5,
+			"expirationTime": 0,
+			"actionFee": 0.01,
+			"actions": [{"actionType": "TransferChx", "actionData": {"recipientAddress": "CHxxx", "amount": 10}}],
+			"status": "Failure",
+			"errorCode": 210,
+			"failedActionNumber": 1,
+			"includedInBlockNumber": 42
+		}`,
+	})
+
+	txInfo, err := client.GetTx(context.Background(), "TxH1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "TxH1", txInfo.TxHash)
+	assert.Equal(t, int64(5), txInfo.Nonce)
+	assert.Equal(t, TxStatusFailed, txInfo.Status)
+	assert.Equal(t, 210, *txInfo.ErrorCode)
+	assert.Equal(t, 1, *txInfo.FailedActionNumber)
+	assert.Equal(t, int64(42), *txInfo.IncludedInBlockNumber)
+	assert.Equal(t, 1, len(txInfo.Actions))
+	assert.Equal(t, ActionTypeTransferChx, txInfo.Actions[0].ActionType)
+}
+
+func TestClientGetTxNotFound(t *testing.T) {
+	client := newTestNode(t, map[string]string{})
+
+	txInfo, err := client.GetTx(context.Background(), "Missing")
+
+	assert.Nil(t, txInfo)
+	assert.True(t, IsNotFoundError(err))
+}
+
+func TestIsNotFoundErrorUnwraps(t *testing.T) {
+	notFound := &ApiError{StatusCode: http.StatusNotFound}
+
+	assert.True(t, IsNotFoundError(fmt.Errorf("cached lookup: %w", notFound)))
+	assert.False(t, IsNotFoundError(fmt.Errorf("cached lookup: %v", notFound)))
+	assert.False(t, IsNotFoundError(nil))
+}
+
+func TestClientApiErrorWithNonJsonBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		io.WriteString(w, "<html>Bad Gateway</html>\n")
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL).GetTx(context.Background(), "TxH1")
+
+	var apiErr *ApiError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	assert.Equal(t, []string{"<html>Bad Gateway</html>"}, apiErr.Errors)
+}
+
+func TestClientHonorsContextCancellation(t *testing.T) {
+	client := newTestNode(t, map[string]string{"GET /tx/TxH1": `{}`})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetTx(ctx, "TxH1")
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Chain State
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestClientGetAddressInfo(t *testing.T) {
+	client := newTestNode(t, map[string]string{
+		"GET /address/CHPJ6aVwpGBRf1dv6Ey1TuhJzt1VtCP5LYB": `{
+			"blockchainAddress": "CHPJ6aVwpGBRf1dv6Ey1TuhJzt1VtCP5LYB",
+			"nonce": 7,
+			"balance": {"total": 100.5, "staked": 50, "deposit": 0, "available": 50.5}
+		}`,
+	})
+
+	info, err := client.GetAddressInfo(context.Background(), "CHPJ6aVwpGBRf1dv6Ey1TuhJzt1VtCP5LYB")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), info.Nonce)
+	assert.Equal(t, MustParseAmount("100.5"), info.Balance.Total)
+	assert.Equal(t, MustParseAmount("50.5"), info.Balance.Available)
+}
+
+func TestClientGetAddressAccountsAssetsAndStakes(t *testing.T) {
+	client := newTestNode(t, map[string]string{
+		"GET /address/CHa/accounts": `{"accounts": ["AccH1", "AccH2"]}`,
+		"GET /address/CHa/assets":   `{"assets": ["AssetH1"]}`,
+		"GET /address/CHa/stakes":   `{"stakes": [{"validatorAddress": "CHv", "amount": 500}]}`,
+	})
+	ctx := context.Background()
+
+	accounts, err := client.GetAddressAccounts(ctx, "CHa")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"AccH1", "AccH2"}, accounts.Accounts)
+
+	assets, err := client.GetAddressAssets(ctx, "CHa")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"AssetH1"}, assets.Assets)
+
+	stakes, err := client.GetAddressStakes(ctx, "CHa")
+	assert.NoError(t, err)
+	assert.Equal(t, []StakeInfoDto{{ValidatorAddress: "CHv", Amount: MustParseAmount("500")}}, stakes.Stakes)
+}
+
+func TestClientGetAccountAndAsset(t *testing.T) {
+	client := newTestNode(t, map[string]string{
+		"GET /account/AccH1": `{"accountHash": "AccH1", "controllerAddress": "CHc", "holdings": [{"assetHash": "AssetH1", "balance": 12.5}]}`,
+		"GET /asset/AssetH1": `{"assetHash": "AssetH1", "assetCode": "AST1", "controllerAddress": "CHc", "isEligibilityRequired": true}`,
+	})
+	ctx := context.Background()
+
+	account, err := client.GetAccount(ctx, "AccH1")
+	assert.NoError(t, err)
+	assert.Equal(t, "CHc", account.ControllerAddress)
+	assert.Equal(t, []HoldingInfoDto{{AssetHash: "AssetH1", Balance: MustParseAmount("12.5")}}, account.Holdings)
+
+	asset, err := client.GetAsset(ctx, "AssetH1")
+	assert.NoError(t, err)
+	assert.Equal(t, "AST1", asset.AssetCode)
+	assert.True(t, asset.IsEligibilityRequired)
+}
+
+func TestClientGetBlockAndValidators(t *testing.T) {
+	client := newTestNode(t, map[string]string{
+		"GET /block/42":                   `{"number": 42, "hash": "BlockH42", "previousHash": "BlockH41", "timestamp": 1550000000000, "txSet": ["TxH1"]}`,
+		"GET /validators?activeOnly=true": `{"validators": [{"validatorAddress": "CHv", "networkAddress": "val01:25718", "sharedRewardPercent": 20, "isActive": true}]}`,
+		"GET /validator/CHv/stakes":       `{"stakes": [{"stakerAddress": "CHs", "amount": 1000}]}`,
+	})
+	ctx := context.Background()
+
+	block, err := client.GetBlock(ctx, 42)
+	assert.NoError(t, err)
+	assert.Equal(t, "BlockH42", block.Hash)
+	assert.Equal(t, []string{"TxH1"}, block.TxSet)
+
+	validators, err := client.GetValidators(ctx, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "val01:25718", validators.Validators[0].NetworkAddress)
+
+	stakes, err := client.GetValidatorStakes(ctx, "CHv")
+	assert.NoError(t, err)
+	assert.Equal(t, "CHs", stakes.Stakes[0].StakerAddress)
+}
@@ -0,0 +1,172 @@
+// Command own wraps the Own blockchain SDK for wallet management and offline tx composition.
+//
+// Secrets (mnemonics, passwords, private keys) are never accepted as arguments. They are read
+// from the file given with the corresponding --*-file flag, or otherwise from stdin, one per line.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	ownSdk "github.com/OwnMarket/own-blockchain-sdk-go"
+)
+
+const usage = `Usage: own <command> <subcommand> [flags]
+
+Commands:
+    wallet new [--mnemonic [--words N]]     Generate a new wallet
+    wallet from-mnemonic --index N          Derive wallets from a mnemonic
+    keystore create                         Encrypt a mnemonic into a keystore
+    keystore open --keystore FILE           Derive wallets from a keystore
+    tx build --sender ADDR --nonce N ...    Compose an unsigned transaction
+    tx sign --network CODE                  Sign a transaction
+    tx inspect --network CODE               Decode and verify a signed transaction
+    address validate ADDRESS                Check a blockchain address
+
+Run "own <command> <subcommand> --help" for the flags of a subcommand.
+`
+
+var errUsage = errors.New("usage error")
+
+type cli struct {
+	stdin  *bufio.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	c := &cli{
+		stdin:  bufio.NewReader(stdin),
+		stdout: stdout,
+		stderr: stderr,
+	}
+
+	if len(args) < 2 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	commands := map[string]func([]string) error{
+		"wallet new":           c.walletNew,
+		"wallet from-mnemonic": c.walletFromMnemonic,
+		"keystore create":      c.keystoreCreate,
+		"keystore open":        c.keystoreOpen,
+		"tx build":             c.txBuild,
+		"tx sign":              c.txSign,
+		"tx inspect":           c.txInspect,
+		"address validate":     c.addressValidate,
+	}
+
+	command, ok := commands[args[0]+" "+args[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "Unknown command: %s %s\n\n%s", args[0], args[1], usage)
+		return 2
+	}
+
+	if err := command(args[2:]); err != nil {
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *cli) newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("own "+name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	return flags
+}
+
+// readSecret reads a secret from the file, or the next line of stdin if no file is given.
+func (c *cli) readSecret(path string, name string) (string, error) {
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	line, err := c.stdin.ReadString('\n')
+	if err != nil && !(err == io.EOF && line != "") {
+		return "", fmt.Errorf("cannot read %s from stdin: %v", name, err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// readInput reads a whole document from the file, or all of stdin if no file is given.
+func (c *cli) readInput(path string) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+	return io.ReadAll(c.stdin)
+}
+
+func (c *cli) printJson(value interface{}) error {
+	b, err := json.MarshalIndent(value, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(c.stdout, string(b))
+	return err
+}
+
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ", ")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Address
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *cli) addressValidate(args []string) error {
+	flags := c.newFlagSet("address validate")
+	jsonOutput := flags.Bool("json", false, "Output JSON")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(c.stderr, "Usage: own address validate [--json] ADDRESS")
+		return errUsage
+	}
+
+	address := flags.Arg(0)
+	isValid := ownSdk.IsValidBlockchainAddress(address)
+	if *jsonOutput {
+		if err := c.printJson(map[string]interface{}{"address": address, "isValid": isValid}); err != nil {
+			return err
+		}
+	} else if isValid {
+		fmt.Fprintln(c.stdout, "valid")
+	} else {
+		fmt.Fprintln(c.stdout, "invalid")
+	}
+
+	if !isValid {
+		return fmt.Errorf("invalid blockchain address %q", address)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ownSdk "github.com/OwnMarket/own-blockchain-sdk-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const testNetworkCode = "OWN_PUBLIC_BLOCKCHAIN_MAINNET"
+
+func runCli(t *testing.T, stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	exitCode := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return exitCode, stdout.String(), stderr.String()
+}
+
+func writeTempFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Wallet
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestWalletNew(t *testing.T) {
+	exitCode, stdout, stderr := runCli(t, "", "wallet", "new", "--json")
+	assert.Equal(t, 0, exitCode, stderr)
+
+	var output walletsOutput
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	assert.Empty(t, output.Mnemonic)
+	assert.Len(t, output.Wallets, 1)
+	assert.Equal(t, ownSdk.AddressFromPrivateKey(output.Wallets[0].PrivateKey), output.Wallets[0].Address)
+	assert.Empty(t, output.Wallets[0].DerivationPath)
+
+	exitCode, stdout, _ = runCli(t, "", "wallet", "new")
+	assert.Equal(t, 0, exitCode)
+	assert.Contains(t, stdout, "Address:")
+	assert.NotContains(t, stdout, "Mnemonic:")
+}
+
+func TestWalletNewWithMnemonic(t *testing.T) {
+	exitCode, stdout, stderr := runCli(t, "", "wallet", "new", "--mnemonic", "--words", "12", "--language", "spanish", "--json")
+	assert.Equal(t, 0, exitCode, stderr)
+
+	var output walletsOutput
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	assert.Len(t, strings.Fields(output.Mnemonic), 12)
+	assert.NoError(t, ownSdk.ValidateMnemonicInLanguage(output.Mnemonic, ownSdk.MnemonicSpanish))
+	seed := ownSdk.GenerateSeedFromMnemonic(output.Mnemonic, "")
+	assert.Len(t, output.Wallets, 1)
+	assert.Equal(t, ownSdk.GenerateWalletFromSeed(seed, 0).Address, output.Wallets[0].Address)
+	assert.Equal(t, "m/44'/25718'/0'/0/0", output.Wallets[0].DerivationPath)
+
+	exitCode, _, stderr = runCli(t, "", "wallet", "new", "--mnemonic", "--words", "13")
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, ownSdk.ErrMnemonicWordCount.Error())
+}
+
+func TestWalletFromMnemonic(t *testing.T) {
+	mnemonic := ownSdk.GenerateMnemonic()
+	seed := ownSdk.GenerateSeedFromMnemonic(mnemonic, "")
+
+	exitCode, stdout, _ := runCli(t, mnemonic+"\n", "wallet", "from-mnemonic", "--index", "2", "--count", "2", "--json")
+	assert.Equal(t, 0, exitCode)
+
+	var output walletsOutput
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	assert.Len(t, output.Wallets, 2)
+	assert.Equal(t, ownSdk.GenerateWalletFromSeed(seed, 2).Address, output.Wallets[0].Address)
+	assert.Equal(t, ownSdk.GenerateWalletFromSeed(seed, 3).Address, output.Wallets[1].Address)
+	assert.Equal(t, "m/44'/25718'/0'/0/3", output.Wallets[1].DerivationPath)
+
+	exitCode, stdout, _ = runCli(t, mnemonic+"\n", "wallet", "from-mnemonic", "--path", "m/44'/25718'/3'/1/7", "--json")
+	assert.Equal(t, 0, exitCode)
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	expectedWallet := ownSdk.GenerateWalletFromSeedAtPath(seed, ownSdk.NewDerivationPath(3, ownSdk.InternalChain, 7))
+	assert.Equal(t, expectedWallet.Address, output.Wallets[0].Address)
+}
+
+func TestWalletFromMnemonicRejectsOutOfRangeFlags(t *testing.T) {
+	mnemonic := ownSdk.GenerateMnemonic()
+
+	inlineData := []struct {
+		flags       []string
+		expectedErr string
+	}{
+		{[]string{"--account", "2147483648"}, "--account must be lower than 2147483648"},
+		{[]string{"--chain", "4294967296"}, "--chain must be lower than 2147483648"},
+		{[]string{"--index", "4294967297"}, "--index must be lower than 2147483648"},
+		{[]string{"--index", "2147483647", "--count", "2"}, "--index plus --count must not exceed 2147483648"},
+		{[]string{"--count", "4294967297"}, "--index plus --count must not exceed 2147483648"},
+	}
+
+	for _, data := range inlineData {
+		args := append([]string{"wallet", "from-mnemonic"}, data.flags...)
+		exitCode, stdout, stderr := runCli(t, mnemonic+"\n", args...)
+		assert.Equal(t, 1, exitCode, data.flags)
+		assert.Equal(t, "", stdout, data.flags)
+		assert.Contains(t, stderr, data.expectedErr, data.flags)
+	}
+}
+
+func TestUnknownCommand(t *testing.T) {
+	exitCode, _, stderr := runCli(t, "", "wallet", "steal")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "Unknown command")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Keystore
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestKeystoreCreateAndOpen(t *testing.T) {
+	mnemonic := ownSdk.GenerateMnemonic()
+	seed := ownSdk.GenerateSeedFromMnemonic(mnemonic, "")
+
+	exitCode, keystore, _ := runCli(t, mnemonic+"\npass\n", "keystore", "create")
+	assert.Equal(t, 0, exitCode)
+	keystoreFile := writeTempFile(t, "keystore.json", keystore)
+
+	exitCode, stdout, _ := runCli(t, "pass\n", "keystore", "open", "--keystore", keystoreFile, "--index", "1", "--count", "2", "--json")
+	assert.Equal(t, 0, exitCode)
+
+	var output walletsOutput
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	assert.Len(t, output.Wallets, 2)
+	assert.Equal(t, ownSdk.GenerateWalletFromSeed(seed, 1).Address, output.Wallets[0].Address)
+	assert.Equal(t, ownSdk.GenerateWalletFromSeed(seed, 2).PrivateKey, output.Wallets[1].PrivateKey)
+}
+
+func TestKeystoreCreateAndOpenWithFiles(t *testing.T) {
+	mnemonic := ownSdk.GenerateMnemonic()
+	mnemonicFile := writeTempFile(t, "mnemonic.txt", mnemonic+"\n")
+	passwordFile := writeTempFile(t, "password.txt", "pass\n")
+	keystoreFile := filepath.Join(t.TempDir(), "keystore.json")
+
+	exitCode, stdout, _ := runCli(t, "", "keystore", "create",
+		"--mnemonic-file", mnemonicFile, "--password-file", passwordFile, "--out", keystoreFile)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "", stdout)
+	info, err := os.Stat(keystoreFile)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	exitCode, stdout, _ = runCli(t, "", "keystore", "open", "--keystore", keystoreFile,
+		"--password-file", passwordFile, "--path", "m/44'/25718'/3'/1/7", "--json")
+	assert.Equal(t, 0, exitCode)
+
+	var output walletsOutput
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	seed := ownSdk.GenerateSeedFromMnemonic(mnemonic, "")
+	expectedWallet := ownSdk.GenerateWalletFromSeedAtPath(seed, ownSdk.NewDerivationPath(3, ownSdk.InternalChain, 7))
+	assert.Equal(t, expectedWallet.Address, output.Wallets[0].Address)
+}
+
+func TestKeystoreOpenWrongPassword(t *testing.T) {
+	mnemonic := ownSdk.GenerateMnemonic()
+	_, keystore, _ := runCli(t, mnemonic+"\npass\n", "keystore", "create")
+	keystoreFile := writeTempFile(t, "keystore.json", keystore)
+
+	exitCode, stdout, stderr := runCli(t, "wrong\n", "keystore", "open", "--keystore", keystoreFile)
+	assert.Equal(t, 1, exitCode)
+	assert.Equal(t, "", stdout)
+	assert.Contains(t, stderr, "decryption failed")
+	assert.NotContains(t, stderr, mnemonic)
+}
+
+func TestKeystoreInvalidUsage(t *testing.T) {
+	exitCode, _, stderr := runCli(t, "pass\n", "keystore", "open")
+	assert.Equal(t, 2, exitCode)
+	assert.Contains(t, stderr, "--keystore is required")
+
+	exitCode, stdout, stderr := runCli(t, ownSdk.GenerateMnemonic()+"\n\n", "keystore", "create")
+	assert.Equal(t, 1, exitCode)
+	assert.Equal(t, "", stdout)
+	assert.Contains(t, stderr, "password must not be empty")
+
+	exitCode, _, stderr = runCli(t, "not a mnemonic\npass\n", "keystore", "create")
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, "invalid mnemonic")
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Tx
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestTxBuildSignInspect(t *testing.T) {
+	wallet := ownSdk.GenerateWallet()
+	recipient := ownSdk.GenerateWallet().Address
+
+	actionsFile := writeTempFile(t, "actions.json", `[
+  {"actionType": "TransferChx", "actionData": {"recipientAddress": "`+recipient+`", "amount": 0.1234567}}
+]`)
+	exitCode, txJson, stderr := runCli(t, "",
+		"tx", "build", "--sender", wallet.Address, "--nonce", "5", "--fee", "0.01",
+		"--actions-file", actionsFile, "--delegate-stake", recipient+"=100")
+	assert.Equal(t, 0, exitCode, stderr)
+
+	tx, err := ownSdk.ParseTx(txJson)
+	assert.NoError(t, err)
+	assert.Len(t, tx.Actions, 2)
+	assert.Equal(t, ownSdk.MustParseAmount("0.1234567"), tx.Actions[0].ActionData.(ownSdk.TransferChxTxActionDto).Amount)
+
+	txFile := writeTempFile(t, "tx.json", txJson)
+	exitCode, signedTxJson, stderr := runCli(t, wallet.PrivateKey+"\n", "tx", "sign", "--network", testNetworkCode, "--tx-file", txFile)
+	assert.Equal(t, 0, exitCode, stderr)
+
+	exitCode, stdout, stderr := runCli(t, signedTxJson, "tx", "inspect", "--network", testNetworkCode, "--json")
+	assert.Equal(t, 0, exitCode, stderr)
+
+	var output txInspectOutput
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &output))
+	assert.Equal(t, wallet.Address, output.SignerAddress)
+	assert.Equal(t, tx.TxHash(), output.TxHash)
+
+	exitCode, _, stderr = runCli(t, signedTxJson, "tx", "inspect", "--network", "OWN_PUBLIC_BLOCKCHAIN_TESTNET")
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, "Error")
+}
+
+func TestTxSignRejectsWrongKey(t *testing.T) {
+	tx := ownSdk.CreateTx(ownSdk.GenerateWallet().Address, 1, ownSdk.MustParseAmount("0.01"), 0)
+	txFile := writeTempFile(t, "tx.json", tx.ToJson(false))
+
+	exitCode, _, stderr := runCli(t, ownSdk.GenerateWallet().PrivateKey+"\n", "tx", "sign", "--network", testNetworkCode, "--tx-file", txFile)
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stderr, ownSdk.ErrSignerMismatch.Error())
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Address
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestAddressValidate(t *testing.T) {
+	exitCode, stdout, _ := runCli(t, "", "address", "validate", "CHPvS1Hxs4oLcrbgKWYYmubSBjurjUdvjg8")
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "valid\n", stdout)
+
+	exitCode, stdout, _ = runCli(t, "", "address", "validate", "--json", "CHPvS1Hxs4oLcgKccYmubSBjurjUdvjg8")
+	assert.Equal(t, 1, exitCode)
+	assert.Contains(t, stdout, `"isValid": false`)
+}
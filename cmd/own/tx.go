@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	ownSdk "github.com/OwnMarket/own-blockchain-sdk-go"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Actions File
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func parseActionsFile(data []byte) ([]ownSdk.TxAction, error) {
+	// The file holds either a list of actions or an object with an "actions" list.
+	actionsJson := bytes.TrimSpace(data)
+	if len(actionsJson) > 0 && actionsJson[0] == '[' {
+		actionsJson = []byte(`{"actions":` + string(actionsJson) + `}`)
+	}
+
+	tx, err := ownSdk.ParseTx(string(actionsJson))
+	if err != nil {
+		return nil, err
+	}
+	return tx.Actions, nil
+}
+
+func parseAddressAmount(value string) (string, ownSdk.Amount, error) {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 {
+		return "", ownSdk.Amount{}, fmt.Errorf("expected ADDRESS=AMOUNT, got %q", value)
+	}
+	amount, err := ownSdk.ParseAmount(parts[1])
+	if err != nil {
+		return "", ownSdk.Amount{}, err
+	}
+	return parts[0], amount, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Commands
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *cli) txBuild(args []string) error {
+	flags := c.newFlagSet("tx build")
+	sender := flags.String("sender", "", "Sender address (required)")
+	nonce := flags.Int64("nonce", 0, "Transaction nonce (required)")
+	fee := flags.String("fee", "", "Action fee in CHX (required)")
+	expirationTime := flags.Int64("expiration", 0, "Expiration time in Unix milliseconds (0 = no expiration)")
+	ttl := flags.Duration("ttl", 0, "Expire the transaction this long from now, e.g. 10m (overrides --expiration)")
+	actionsFile := flags.String("actions-file", "", "JSON file with actions to add")
+	var transferChx, delegateStake stringList
+	flags.Var(&transferChx, "transfer-chx", "Add a TransferChx action: RECIPIENT=AMOUNT (repeatable)")
+	flags.Var(&delegateStake, "delegate-stake", "Add a DelegateStake action: VALIDATOR=AMOUNT (repeatable)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *sender == "" || *nonce == 0 || *fee == "" {
+		fmt.Fprintln(c.stderr, "--sender, --nonce and --fee are required")
+		return errUsage
+	}
+
+	actionFee, err := ownSdk.ParseAmount(*fee)
+	if err != nil {
+		return err
+	}
+	tx := ownSdk.CreateTx(*sender, *nonce, actionFee, *expirationTime)
+	if *ttl > 0 {
+		tx = ownSdk.CreateTxWithTTL(*sender, *nonce, actionFee, *ttl)
+	}
+
+	if *actionsFile != "" {
+		data, err := c.readInput(*actionsFile)
+		if err != nil {
+			return err
+		}
+		actions, err := parseActionsFile(data)
+		if err != nil {
+			return err
+		}
+		tx.Actions = append(tx.Actions, actions...)
+	}
+	for _, value := range transferChx {
+		recipient, amount, err := parseAddressAmount(value)
+		if err != nil {
+			return err
+		}
+		tx.AddTransferChxAction(recipient, amount)
+	}
+	for _, value := range delegateStake {
+		validator, amount, err := parseAddressAmount(value)
+		if err != nil {
+			return err
+		}
+		tx.AddDelegateStakeAction(validator, amount)
+	}
+
+	return c.printJson(tx)
+}
+
+func (c *cli) txSign(args []string) error {
+	flags := c.newFlagSet("tx sign")
+	networkCode := flags.String("network", "", "Network code, e.g. OWN_PUBLIC_BLOCKCHAIN_MAINNET (required)")
+	txFile := flags.String("tx-file", "", "File containing the tx JSON (default: read from stdin)")
+	keyFile := flags.String("key-file", "", "File containing the private key (default: read from stdin)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *networkCode == "" {
+		fmt.Fprintln(c.stderr, "--network is required")
+		return errUsage
+	}
+	if *txFile == "" && *keyFile == "" {
+		fmt.Fprintln(c.stderr, "Only one of tx and private key can be read from stdin; use --tx-file or --key-file")
+		return errUsage
+	}
+
+	privateKey, err := c.readSecret(*keyFile, "private key")
+	if err != nil {
+		return err
+	}
+	signer, err := ownSdk.NewSignerFromWallet(&ownSdk.WalletInfo{PrivateKey: privateKey})
+	if err != nil {
+		return err
+	}
+	defer signer.Zero()
+
+	txJson, err := c.readInput(*txFile)
+	if err != nil {
+		return err
+	}
+	tx, err := ownSdk.ParseTx(string(txJson))
+	if err != nil {
+		return err
+	}
+
+	signedTx, err := tx.SignWith(*networkCode, signer)
+	if err != nil {
+		return err
+	}
+	return c.printJson(signedTx)
+}
+
+type txInspectOutput struct {
+	TxHash        string     `json:"txHash"`
+	SignerAddress string     `json:"signerAddress"`
+	Tx            *ownSdk.Tx `json:"tx"`
+}
+
+func (c *cli) txInspect(args []string) error {
+	flags := c.newFlagSet("tx inspect")
+	networkCode := flags.String("network", "", "Network code the tx was signed for (required)")
+	signedTxFile := flags.String("signed-tx-file", "", "File containing the signed tx JSON (default: read from stdin)")
+	jsonOutput := flags.Bool("json", false, "Output JSON")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *networkCode == "" {
+		fmt.Fprintln(c.stderr, "--network is required")
+		return errUsage
+	}
+
+	signedTxJson, err := c.readInput(*signedTxFile)
+	if err != nil {
+		return err
+	}
+	signedTx, err := ownSdk.ParseSignedTx(string(signedTxJson))
+	if err != nil {
+		return err
+	}
+	tx, err := signedTx.Verify(*networkCode)
+	if err != nil {
+		return err
+	}
+
+	output := txInspectOutput{
+		TxHash:        signedTx.TxHash(),
+		SignerAddress: tx.SenderAddress,
+		Tx:            tx,
+	}
+	if *jsonOutput {
+		return c.printJson(output)
+	}
+
+	fmt.Fprintf(c.stdout, "Tx hash:   %s\n", output.TxHash)
+	fmt.Fprintf(c.stdout, "Signed by: %s (signature valid)\n", output.SignerAddress)
+	fmt.Fprintln(c.stdout, tx.ToJson(true))
+	return nil
+}
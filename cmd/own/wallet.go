@@ -0,0 +1,257 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	ownSdk "github.com/OwnMarket/own-blockchain-sdk-go"
+)
+
+type walletOutput struct {
+	Index          *uint32 `json:"index,omitempty"`
+	DerivationPath string  `json:"derivationPath,omitempty"`
+	Address        string  `json:"address"`
+	PrivateKey     string  `json:"privateKey"`
+}
+
+type walletsOutput struct {
+	Mnemonic string         `json:"mnemonic,omitempty"`
+	Wallets  []walletOutput `json:"wallets"`
+}
+
+func (c *cli) printWallets(output walletsOutput, jsonOutput bool) error {
+	if jsonOutput {
+		return c.printJson(output)
+	}
+
+	if output.Mnemonic != "" {
+		fmt.Fprintf(c.stdout, "Mnemonic:    %s\n", output.Mnemonic)
+	}
+	for _, wallet := range output.Wallets {
+		if wallet.DerivationPath != "" {
+			fmt.Fprintf(c.stdout, "Path:        %s\n", wallet.DerivationPath)
+		}
+		fmt.Fprintf(c.stdout, "Address:     %s\n", wallet.Address)
+		fmt.Fprintf(c.stdout, "Private key: %s\n", wallet.PrivateKey)
+	}
+	return nil
+}
+
+type derivationFlags struct {
+	account *uint
+	chain   *uint
+	index   *uint
+	count   *uint
+	path    *string
+}
+
+func addDerivationFlags(flags *flag.FlagSet) *derivationFlags {
+	return &derivationFlags{
+		account: flags.Uint("account", 0, "BIP44 account"),
+		chain:   flags.Uint("chain", uint(ownSdk.ExternalChain), "BIP44 chain (0 = external, 1 = internal/change)"),
+		index:   flags.Uint("index", 0, "Index of the first wallet to derive"),
+		count:   flags.Uint("count", 1, "Number of wallets to derive"),
+		path:    flags.String("path", "", "Derive a single wallet at this path, e.g. m/44'/25718'/3'/0/7"),
+	}
+}
+
+// derivationIndex converts a flag value to a non-hardened BIP32 index.
+func derivationIndex(name string, value uint) (uint32, error) {
+	if uint64(value) >= uint64(ownSdk.HardenedKeyStart) {
+		return 0, fmt.Errorf("--%s must be lower than %d", name, ownSdk.HardenedKeyStart)
+	}
+	return uint32(value), nil
+}
+
+func (f *derivationFlags) deriveWallets(seed []byte) ([]walletOutput, error) {
+	var derived [](*ownSdk.WalletInfo)
+	if *f.path != "" {
+		path, err := ownSdk.ParseDerivationPath(*f.path)
+		if err != nil {
+			return nil, err
+		}
+		wallet, err := ownSdk.TryGenerateWalletFromSeedAtPath(seed, path)
+		if err != nil {
+			return nil, err
+		}
+		derived = append(derived, wallet)
+	} else {
+		account, err := derivationIndex("account", *f.account)
+		if err != nil {
+			return nil, err
+		}
+		chain, err := derivationIndex("chain", *f.chain)
+		if err != nil {
+			return nil, err
+		}
+		index, err := derivationIndex("index", *f.index)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(*f.index)+uint64(*f.count) > uint64(ownSdk.HardenedKeyStart) {
+			return nil, fmt.Errorf("--index plus --count must not exceed %d", ownSdk.HardenedKeyStart)
+		}
+
+		deriver, err := ownSdk.NewWalletDeriver(seed, account, chain)
+		if err != nil {
+			return nil, err
+		}
+		if derived, err = deriver.Wallets(index, uint32(*f.count)); err != nil {
+			return nil, err
+		}
+	}
+
+	wallets := make([]walletOutput, 0, len(derived))
+	for _, wallet := range derived {
+		output := walletOutput{DerivationPath: wallet.DerivationPath.String(), Address: wallet.Address, PrivateKey: wallet.PrivateKey}
+		if *f.path == "" {
+			index := wallet.DerivationPath[len(wallet.DerivationPath)-1]
+			output.Index = &index
+		}
+		wallets = append(wallets, output)
+	}
+	return wallets, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Wallet
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *cli) walletNew(args []string) error {
+	flags := c.newFlagSet("wallet new")
+	withMnemonic := flags.Bool("mnemonic", false, "Generate a mnemonic and derive the wallet at index 0 from it")
+	words := flags.Int("words", ownSdk.DefaultMnemonicWordCount, "Number of mnemonic words (12, 15, 18, 21 or 24)")
+	language := flags.String("language", string(ownSdk.MnemonicEnglish), "Mnemonic wordlist language")
+	jsonOutput := flags.Bool("json", false, "Output JSON")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if !*withMnemonic {
+		wallet, err := ownSdk.TryGenerateWallet()
+		if err != nil {
+			return err
+		}
+		output := walletsOutput{Wallets: []walletOutput{{Address: wallet.Address, PrivateKey: wallet.PrivateKey}}}
+		return c.printWallets(output, *jsonOutput)
+	}
+
+	mnemonic, err := ownSdk.TryGenerateMnemonicWithOptions(ownSdk.MnemonicLanguage(*language), *words)
+	if err != nil {
+		return err
+	}
+	seed, err := ownSdk.TryGenerateSeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		return err
+	}
+	wallet, err := ownSdk.TryGenerateWalletFromSeed(seed, 0)
+	if err != nil {
+		return err
+	}
+	wallets := []walletOutput{{DerivationPath: wallet.DerivationPath.String(), Address: wallet.Address, PrivateKey: wallet.PrivateKey}}
+	return c.printWallets(walletsOutput{Mnemonic: mnemonic, Wallets: wallets}, *jsonOutput)
+}
+
+func (c *cli) walletFromMnemonic(args []string) error {
+	flags := c.newFlagSet("wallet from-mnemonic")
+	derivation := addDerivationFlags(flags)
+	mnemonicFile := flags.String("mnemonic-file", "", "File containing the mnemonic (default: read from stdin)")
+	passphraseFile := flags.String("passphrase-file", "", "File containing the optional BIP39 passphrase")
+	jsonOutput := flags.Bool("json", false, "Output JSON")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	mnemonic, err := c.readSecret(*mnemonicFile, "mnemonic")
+	if err != nil {
+		return err
+	}
+	passphrase := ""
+	if *passphraseFile != "" {
+		if passphrase, err = c.readSecret(*passphraseFile, "passphrase"); err != nil {
+			return err
+		}
+	}
+
+	seed, err := ownSdk.TryGenerateSeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return err
+	}
+	wallets, err := derivation.deriveWallets(seed)
+	if err != nil {
+		return err
+	}
+	return c.printWallets(walletsOutput{Wallets: wallets}, *jsonOutput)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Keystore
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *cli) keystoreCreate(args []string) error {
+	flags := c.newFlagSet("keystore create")
+	mnemonicFile := flags.String("mnemonic-file", "", "File containing the mnemonic (default: first line of stdin)")
+	passwordFile := flags.String("password-file", "", "File containing the keystore password (default: next line of stdin)")
+	out := flags.String("out", "", "Write the keystore to this file instead of stdout")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	mnemonic, err := c.readSecret(*mnemonicFile, "mnemonic")
+	if err != nil {
+		return err
+	}
+	password, err := c.readSecret(*passwordFile, "password")
+	if err != nil {
+		return err
+	}
+	if password == "" {
+		return fmt.Errorf("password must not be empty")
+	}
+
+	keystore, err := ownSdk.CreateKeystoreFromPassword(mnemonic, password)
+	if err != nil {
+		return err
+	}
+
+	if *out != "" {
+		return os.WriteFile(*out, keystore, 0600)
+	}
+	_, err = fmt.Fprintln(c.stdout, string(keystore))
+	return err
+}
+
+func (c *cli) keystoreOpen(args []string) error {
+	flags := c.newFlagSet("keystore open")
+	keystoreFile := flags.String("keystore", "", "Keystore file (required)")
+	passwordFile := flags.String("password-file", "", "File containing the keystore password (default: read from stdin)")
+	derivation := addDerivationFlags(flags)
+	jsonOutput := flags.Bool("json", false, "Output JSON")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *keystoreFile == "" {
+		fmt.Fprintln(c.stderr, "--keystore is required")
+		return errUsage
+	}
+
+	keystore, err := os.ReadFile(*keystoreFile)
+	if err != nil {
+		return err
+	}
+	password, err := c.readSecret(*passwordFile, "password")
+	if err != nil {
+		return err
+	}
+
+	seed, err := ownSdk.OpenKeystore(keystore, password)
+	if err != nil {
+		return err
+	}
+	wallets, err := derivation.deriveWallets(seed)
+	if err != nil {
+		return err
+	}
+	return c.printWallets(walletsOutput{Wallets: wallets}, *jsonOutput)
+}
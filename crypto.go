@@ -5,21 +5,21 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/ecdsa"
-	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/base64"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
 	"github.com/mr-tron/base58"
 	"github.com/tyler-smith/go-bip32"
-	"github.com/tyler-smith/go-bip39"
 )
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -27,56 +27,87 @@ import (
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type WalletInfo struct {
-	PrivateKey string
-	Address    string
+	PrivateKey     string
+	PublicKey      string // Base58 encoded compressed (33 byte) public key
+	Address        string
+	DerivationPath DerivationPath // nil unless derived from a seed
 }
 
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Errors
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	ErrInvalidEncoding   = errors.New("invalid encoding")
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+	ErrDecryptionFailed  = errors.New("decryption failed")
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+	ErrInvalidPublicKey  = errors.New("invalid public key")
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrInvalidMnemonic   = errors.New("invalid mnemonic")
+	ErrInvalidSeed       = errors.New("invalid seed")
+)
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 // Encryption
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func Encrypt(text []byte, passwordHash [32]byte) []byte {
+func TryEncrypt(text []byte, passwordHash [32]byte) ([]byte, error) {
 	cypher, err := aes.NewCipher(passwordHash[:])
 	if err != nil {
-		fmt.Println(err)
-		return make([]byte, 0)
+		return nil, err
 	}
 
 	gcm, err := cipher.NewGCM(cypher)
 	if err != nil {
-		fmt.Println(err)
-		return make([]byte, 0)
+		return nil, err
 	}
 
 	nonce := make([]byte, gcm.NonceSize())
 	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		panic(err.Error())
+		return nil, err
 	}
 
-	return gcm.Seal(nonce, nonce, text, nil)
+	return gcm.Seal(nonce, nonce, text, nil), nil
 }
 
-func Decrypt(encryptedText []byte, passwordHash [32]byte) []byte {
+func Encrypt(text []byte, passwordHash [32]byte) []byte {
+	encryptedText, err := TryEncrypt(text, passwordHash)
+	if err != nil {
+		panic(err.Error())
+	}
+	return encryptedText
+}
+
+func TryDecrypt(encryptedText []byte, passwordHash [32]byte) ([]byte, error) {
 	cypher, err := aes.NewCipher(passwordHash[:])
 	if err != nil {
-		fmt.Println(err)
+		return nil, err
 	}
 
 	gcm, err := cipher.NewGCM(cypher)
 	if err != nil {
-		fmt.Println(err)
+		return nil, err
 	}
 
 	nonceSize := gcm.NonceSize()
-	if len(encryptedText) < nonceSize {
-		fmt.Println(err)
+	if len(encryptedText) < nonceSize+gcm.Overhead() {
+		return nil, ErrInvalidCiphertext
 	}
 	nonce, encryptedText := encryptedText[:nonceSize], encryptedText[nonceSize:]
 	text, err := gcm.Open(nil, nonce, encryptedText, nil)
 	if err != nil {
-		panic(err.Error())
+		return nil, ErrDecryptionFailed
 	}
 
+	return text, nil
+}
+
+func Decrypt(encryptedText []byte, passwordHash [32]byte) []byte {
+	text, err := TryDecrypt(encryptedText, passwordHash)
+	if err != nil {
+		panic(err.Error())
+	}
 	return text
 }
 
@@ -88,11 +119,16 @@ func Encode64(src []byte) string {
 	return base64.StdEncoding.EncodeToString(src)
 }
 
-func Decode64(src string) []byte {
+func TryDecode64(src string) ([]byte, error) {
 	decoded, err := base64.StdEncoding.DecodeString(src)
 	if err != nil {
-		fmt.Println(err.Error())
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
 	}
+	return decoded, nil
+}
+
+func Decode64(src string) []byte {
+	decoded, _ := TryDecode64(src)
 	return decoded
 }
 
@@ -100,10 +136,17 @@ func Encode58(src []byte) string {
 	return base58.Encode(src)
 }
 
-func Decode58(src string) []byte {
+func TryDecode58(src string) ([]byte, error) {
 	decoded, err := base58.Decode(src)
 	if err != nil {
-		fmt.Println(err.Error())
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	return decoded, nil
+}
+
+func Decode58(src string) []byte {
+	decoded, err := TryDecode58(src)
+	if err != nil {
 		return make([]byte, 0)
 	}
 	return decoded
@@ -131,8 +174,7 @@ func Hash(data []byte) string {
 	return Encode58(_sha256[:])
 }
 
-func DeriveHash(address string, nonce int64, txActionNumber int16) string {
-	addressBytes := Decode58(address)
+func deriveHash(addressBytes []byte, nonce int64, txActionNumber int16) string {
 	nonceBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(nonceBytes, uint64(nonce))
 	txActionNumberBytes := make([]byte, 2)
@@ -144,6 +186,18 @@ func DeriveHash(address string, nonce int64, txActionNumber int16) string {
 	return Hash(concatBytes)
 }
 
+func TryDeriveHash(address string, nonce int64, txActionNumber int16) (string, error) {
+	addressBytes, err := TryDecode58(address)
+	if err != nil {
+		return "", err
+	}
+	return deriveHash(addressBytes, nonce, txActionNumber), nil
+}
+
+func DeriveHash(address string, nonce int64, txActionNumber int16) string {
+	return deriveHash(Decode58(address), nonce, txActionNumber)
+}
+
 func blockchainAddress(publicKey []byte) string {
 	addressPrefix := []byte{6, 90} //CH
 	_xsha256 := xsha256(publicKey)
@@ -160,8 +214,8 @@ func IsValidBlockchainAddress(address string) bool {
 	if address == "" || !strings.HasPrefix(address, "CH") {
 		return false
 	}
-	addressBytes := Decode58(address)
-	if len(addressBytes) != 26 || bytes.Compare(addressBytes[:2], addressPrefix[:]) != 0 {
+	addressBytes, err := TryDecode58(address)
+	if err != nil || len(addressBytes) != 26 || bytes.Compare(addressBytes[:2], addressPrefix[:]) != 0 {
 		return false
 	}
 
@@ -178,171 +232,389 @@ func IsValidBlockchainAddress(address string) bool {
 // Signing
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func GenerateWallet() *WalletInfo {
+func walletFromPrivateKeyBytes(privateKeyBytes []byte) (*WalletInfo, error) {
+	publicKey, err := publicKeyFromPrivateKeyBytes(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	wallet := &WalletInfo{
+		PrivateKey: Encode58(privateKeyBytes),
+		PublicKey:  Encode58(crypto.CompressPubkey(publicKey)),
+		Address:    blockchainAddress(crypto.FromECDSAPub(publicKey)),
+	}
+	return wallet, nil
+}
+
+func TryGenerateWallet() (*WalletInfo, error) {
 	key, err := ecdsa.GenerateKey(secp256k1.S256(), rand.Reader)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	publicKey := elliptic.Marshal(secp256k1.S256(), key.X, key.Y)
+	privateKeyBytes := key.D.FillBytes(make([]byte, 32))
+	defer zeroBytes(privateKeyBytes)
 
-	privateKeyBytes := make([]byte, 32)
-	blob := key.D.Bytes()
-	copy(privateKeyBytes[32-len(blob):], blob)
+	return walletFromPrivateKeyBytes(privateKeyBytes)
+}
 
-	wallet := &WalletInfo{
-		PrivateKey: Encode58(privateKeyBytes),
-		Address:    blockchainAddress(publicKey),
+func GenerateWallet() *WalletInfo {
+	wallet, err := TryGenerateWallet()
+	if err != nil {
+		panic(err)
 	}
 	return wallet
 }
 
+func publicKeyFromPrivateKeyBytes(privateKeyBytes []byte) (*ecdsa.PublicKey, error) {
+	key, err := crypto.ToECDSA(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	return &key.PublicKey, nil
+}
+
+func addressFromPrivateKeyBytes(privateKeyBytes []byte) (string, error) {
+	publicKey, err := publicKeyFromPrivateKeyBytes(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return blockchainAddress(crypto.FromECDSAPub(publicKey)), nil
+}
+
+func TryAddressFromPrivateKey(privateKey string) (string, error) {
+	bytes, err := TryDecode58(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	return addressFromPrivateKeyBytes(bytes)
+}
+
 func AddressFromPrivateKey(privateKey string) string {
-	bytes := Decode58(privateKey)
-	key, err := crypto.ToECDSA(bytes)
+	address, _ := TryAddressFromPrivateKey(privateKey)
+	return address
+}
+
+// TryPublicKeyFromPrivateKey returns the Base58 encoded compressed public key.
+func TryPublicKeyFromPrivateKey(privateKey string) (string, error) {
+	privateKeyBytes, err := TryDecode58(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	publicKey, err := publicKeyFromPrivateKeyBytes(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return Encode58(crypto.CompressPubkey(publicKey)), nil
+}
+
+func PublicKeyFromPrivateKey(privateKey string) string {
+	publicKey, _ := TryPublicKeyFromPrivateKey(privateKey)
+	return publicKey
+}
+
+// parsePublicKeyBytes accepts both compressed (33 byte) and uncompressed (65 byte) public keys.
+func parsePublicKeyBytes(publicKeyBytes []byte) (*ecdsa.PublicKey, error) {
+	var publicKey *ecdsa.PublicKey
+	var err error
+	switch len(publicKeyBytes) {
+	case 33:
+		publicKey, err = crypto.DecompressPubkey(publicKeyBytes)
+	case 65:
+		publicKey, err = crypto.UnmarshalPubkey(publicKeyBytes)
+	default:
+		return nil, fmt.Errorf("%w: expected 33 or 65 bytes, got %d", ErrInvalidPublicKey, len(publicKeyBytes))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	return publicKey, nil
+}
+
+func TryParsePublicKey(publicKey string) (*ecdsa.PublicKey, error) {
+	publicKeyBytes, err := TryDecode58(publicKey)
 	if err != nil {
-		fmt.Println(err.Error())
-		return ""
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
 	}
+	return parsePublicKeyBytes(publicKeyBytes)
+}
 
-	publicKey := elliptic.Marshal(secp256k1.S256(), key.X, key.Y)
-	return blockchainAddress(publicKey)
+// TryAddressFromPublicKey accepts a Base58 encoded compressed or uncompressed public key.
+func TryAddressFromPublicKey(publicKey string) (string, error) {
+	key, err := TryParsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	return blockchainAddress(crypto.FromECDSAPub(key)), nil
+}
+
+func AddressFromPublicKey(publicKey string) string {
+	address, _ := TryAddressFromPublicKey(publicKey)
+	return address
+}
+
+func TryWalletFromPrivateKey(privateKey string) (*WalletInfo, error) {
+	privateKeyBytes, err := TryDecode58(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	wallet, err := walletFromPrivateKeyBytes(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	wallet.PrivateKey = privateKey
+	return wallet, nil
 }
 
 func WalletFromPrivateKey(privateKey string) *WalletInfo {
-	address := AddressFromPrivateKey(privateKey)
-	wallet := &WalletInfo{
-		PrivateKey: privateKey,
-		Address:    address,
+	wallet, err := TryWalletFromPrivateKey(privateKey)
+	if err != nil {
+		return &WalletInfo{PrivateKey: privateKey}
 	}
 	return wallet
 }
 
-func sign(privateKey string, dataHash [32]byte) string {
-	privateKeyBytes := Decode58(privateKey)
+// signDigest produces a deterministic (RFC6979) signature in the canonical low S form.
+func signDigest(privateKeyBytes []byte, dataHash [32]byte) ([]byte, error) {
 	signatureBytes, err := secp256k1.Sign(dataHash[:], privateKeyBytes)
 	if err != nil {
-		fmt.Println(err.Error())
-		return ""
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	normalizeSignature(signatureBytes)
+	if err := validateSignature(signatureBytes); err != nil {
+		return nil, err
 	}
-	return Encode58(signatureBytes)
+	return signatureBytes, nil
 }
 
-func SignMessage(networkCode string, privateKey string, message string) string {
-	messageHash := xsha256([]byte(message))
+func sign(privateKey string, dataHash [32]byte) (string, error) {
+	privateKeyBytes, err := TryDecode58(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	signatureBytes, err := signDigest(privateKeyBytes, dataHash)
+	if err != nil {
+		return "", err
+	}
+	return Encode58(signatureBytes), nil
+}
+
+func recoverAddressFromSignatureBytes(dataHash [32]byte, signatureBytes []byte) (string, error) {
+	publicKey, err := secp256k1.RecoverPubkey(dataHash[:], signatureBytes)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return blockchainAddress(publicKey), nil
+}
+
+var (
+	secp256k1N     = secp256k1.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// validateSignature rejects signatures which are not in the canonical R || S || V form with low S.
+func validateSignature(signatureBytes []byte) error {
+	if len(signatureBytes) != 65 {
+		return fmt.Errorf("%w: expected 65 bytes, got %d", ErrInvalidSignature, len(signatureBytes))
+	}
+	r := new(big.Int).SetBytes(signatureBytes[:32])
+	s := new(big.Int).SetBytes(signatureBytes[32:64])
+	v := signatureBytes[64]
+	if r.Sign() == 0 || r.Cmp(secp256k1N) >= 0 || s.Sign() == 0 || s.Cmp(secp256k1N) >= 0 {
+		return fmt.Errorf("%w: R or S out of range", ErrInvalidSignature)
+	}
+	if s.Cmp(secp256k1HalfN) > 0 {
+		return fmt.Errorf("%w: S is not canonical (high S)", ErrInvalidSignature)
+	}
+	if v > 1 {
+		return fmt.Errorf("%w: invalid recovery ID %d", ErrInvalidSignature, v)
+	}
+	return nil
+}
+
+// normalizeSignature converts a high S signature into the equivalent low S one in place.
+// Negating S negates the nonce point, so the parity in the recovery ID flips as well.
+func normalizeSignature(signatureBytes []byte) {
+	if len(signatureBytes) != 65 {
+		return
+	}
+	s := new(big.Int).SetBytes(signatureBytes[32:64])
+	if s.Cmp(secp256k1HalfN) <= 0 {
+		return
+	}
+	s.Sub(secp256k1N, s).FillBytes(signatureBytes[32:64])
+	signatureBytes[64] ^= 1
+}
+
+// recoverAddress accepts only canonical signatures, matching the malleability rules of the node.
+func recoverAddress(dataHash [32]byte, signature string) (string, error) {
+	signatureBytes, err := TryDecode58(signature)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if err := validateSignature(signatureBytes); err != nil {
+		return "", err
+	}
+	return recoverAddressFromSignatureBytes(dataHash, signatureBytes)
+}
+
+// bytesHash binds the data to the network, so a signature is not valid on other networks.
+func bytesHash(networkCode string, data []byte) [32]byte {
+	dataHash := xsha256(data)
 	networkIdBytes := xsha256([]byte(networkCode))
-	dataToSign := xsha256(append(messageHash[:], networkIdBytes[:]...))
+	return xsha256(append(dataHash[:], networkIdBytes[:]...))
+}
+
+func messageHash(networkCode string, message string) [32]byte {
+	return bytesHash(networkCode, []byte(message))
+}
+
+func TrySignMessage(networkCode string, privateKey string, message string) (string, error) {
+	dataToSign := messageHash(networkCode, message)
 	return sign(privateKey, dataToSign)
 }
 
-func SignPlainText(privateKey string, text string) string {
+func SignMessage(networkCode string, privateKey string, message string) string {
+	signature, _ := TrySignMessage(networkCode, privateKey, message)
+	return signature
+}
+
+func VerifyMessageSignature(networkCode string, signature string, message string) (string, error) {
+	return recoverAddress(messageHash(networkCode, message), signature)
+}
+
+func IsMessageSignedBy(networkCode string, signature string, message string, address string) bool {
+	signerAddress, err := VerifyMessageSignature(networkCode, signature, message)
+	return err == nil && signerAddress == address
+}
+
+// SignDigest signs a precomputed 32 byte digest as is, without hashing it or binding it to a network.
+func SignDigest(privateKey string, digest [32]byte) (string, error) {
+	return sign(privateKey, digest)
+}
+
+// RecoverAddressFromDigest returns the address which produced the signature of the digest.
+func RecoverAddressFromDigest(digest [32]byte, signature string) (string, error) {
+	return recoverAddress(digest, signature)
+}
+
+// SignBytes signs arbitrary binary data the same way SignMessage signs a string, so
+// SignBytes(networkCode, privateKey, []byte(message)) equals SignMessage(networkCode, privateKey, message).
+func SignBytes(networkCode string, privateKey string, data []byte) (string, error) {
+	return sign(privateKey, bytesHash(networkCode, data))
+}
+
+func VerifyBytes(networkCode string, signature string, data []byte) (string, error) {
+	return recoverAddress(bytesHash(networkCode, data), signature)
+}
+
+func TrySignPlainText(privateKey string, text string) (string, error) {
 	dataToSign := xsha256([]byte(text))
 	return sign(privateKey, dataToSign)
 }
 
-func VerifyPlainTextSignature(signature string, text string) string {
+func SignPlainText(privateKey string, text string) string {
+	signature, _ := TrySignPlainText(privateKey, text)
+	return signature
+}
+
+func TryVerifyPlainTextSignature(signature string, text string) (string, error) {
 	dataToVerify := xsha256([]byte(text))
-	signatureBytes := Decode58(signature)
-	publicKey, err := secp256k1.RecoverPubkey(dataToVerify[:], signatureBytes)
-	if err != nil {
-		fmt.Println(err.Error())
-		return ""
-	}
-	return blockchainAddress(publicKey)
+	return recoverAddress(dataToVerify, signature)
+}
+
+func VerifyPlainTextSignature(signature string, text string) string {
+	address, _ := TryVerifyPlainTextSignature(signature, text)
+	return address
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 // Hierarchical Deterministic Cryptography
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+func TryGenerateMnemonic() (string, error) {
+	return TryGenerateMnemonicWithOptions(MnemonicEnglish, DefaultMnemonicWordCount)
+}
+
 func GenerateMnemonic() string {
-	entropy, _ := bip39.NewEntropy(256)
-	mnemonic, _ := bip39.NewMnemonic(entropy)
+	mnemonic, err := TryGenerateMnemonic()
+	if err != nil {
+		panic(err)
+	}
 	return mnemonic
 }
 
-func GenerateSeedFromMnemonic(mnemonic string, passphrase string) []byte {
-	if !bip39.IsMnemonicValid(mnemonic) {
-		panic("Invalid mnemonic")
+func TryGenerateSeedFromMnemonic(mnemonic string, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
 	}
-	return bip39.NewSeed(mnemonic, passphrase)
+	return mnemonicSeed(mnemonic, passphrase), nil
 }
 
-func GenerateSeedFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte) []byte {
-	return Decrypt(keyStoreEncrypted, passwordHash)
+func GenerateSeedFromMnemonic(mnemonic string, passphrase string) []byte {
+	seed, err := TryGenerateSeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		panic(err)
+	}
+	return seed
 }
 
-func generateMasterKeyFromSeed(seed []byte) *bip32.Key {
-	masterKey, _ := bip32.NewMasterKey(seed)
-	return masterKey
+func TryGenerateSeedFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte) ([]byte, error) {
+	return TryDecrypt(keyStoreEncrypted, passwordHash)
 }
 
-func GenerateKeystore(mnemonic string, passwordHash [32]byte) []byte {
-	seed := GenerateSeedFromMnemonic(mnemonic, "")
-	return Encrypt(seed, passwordHash)
+func GenerateSeedFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte) []byte {
+	return Decrypt(keyStoreEncrypted, passwordHash)
 }
 
-// m/44'/{coin}'/0'/0/${address}
-func newKeyFromMasterKey(masterKey *bip32.Key, coin, address uint32) (*bip32.Key, error) {
-	const _purpose uint32 = 44
-	const _account = 0
-	const _chain = 0
-
-	child, err := masterKey.NewChildKey(bip32.FirstHardenedChild + 44) // m/44'
+func generateMasterKeyFromSeed(seed []byte) (*bip32.Key, error) {
+	masterKey, err := bip32.NewMasterKey(seed)
 	if err != nil {
-		return nil, err
-	}
-
-	child, err = child.NewChildKey(bip32.FirstHardenedChild + coin) // m/44'/${coin}'
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSeed, err)
 	}
+	return masterKey, nil
+}
 
-	child, err = child.NewChildKey(bip32.FirstHardenedChild + _account) // m/44'/${coin}'/0'
+func TryGenerateKeystore(mnemonic string, passwordHash [32]byte) ([]byte, error) {
+	seed, err := TryGenerateSeedFromMnemonic(mnemonic, "")
 	if err != nil {
 		return nil, err
 	}
+	return TryEncrypt(seed, passwordHash)
+}
 
-	child, err = child.NewChildKey(_chain) // m/44'/${coin}'/0'/0
-	if err != nil {
-		return nil, err
-	}
+func GenerateKeystore(mnemonic string, passwordHash [32]byte) []byte {
+	seed := GenerateSeedFromMnemonic(mnemonic, "")
+	return Encrypt(seed, passwordHash)
+}
 
-	child, err = child.NewChildKey(address) // m/44'/${coin}'/0'/0/${address}
-	if err != nil {
-		return nil, err
-	}
+func TryGenerateWalletFromSeed(seed []byte, keyIndex uint32) (*WalletInfo, error) {
+	return TryGenerateWalletFromSeedAtPath(seed, NewDerivationPath(0, ExternalChain, keyIndex))
+}
 
-	return child, nil
+func GenerateWalletFromSeed(seed []byte, keyIndex uint32) *WalletInfo {
+	wallet, _ := TryGenerateWalletFromSeed(seed, keyIndex)
+	return wallet
 }
 
-func generateWalletFromSeedWithExplicitCoinIndex(seed []byte, coin uint32, keyIndex uint32) *WalletInfo {
-	masterKey := generateMasterKeyFromSeed(seed)
-	childKey, err := newKeyFromMasterKey(masterKey, coin, keyIndex)
+func TryRestoreWalletsFromSeed(seed []byte, walletCount uint32) ([](*WalletInfo), error) {
+	deriver, err := NewWalletDeriver(seed, 0, ExternalChain)
 	if err != nil {
-		fmt.Println(err)
-	}
-	privateKeyBytes := childKey.Key
-	privateKey := Encode58(privateKeyBytes)
-	wallet := &WalletInfo{
-		PrivateKey: privateKey,
-		Address:    AddressFromPrivateKey(privateKey),
+		return nil, err
 	}
-	return wallet
+	return deriver.Wallets(0, walletCount)
 }
 
-func GenerateWalletFromSeed(seed []byte, keyIndex uint32) *WalletInfo {
-	return generateWalletFromSeedWithExplicitCoinIndex(seed, 25718, keyIndex)
+func RestoreWalletsFromSeed(seed []byte, walletCount uint32) [](*WalletInfo) {
+	wallets, _ := TryRestoreWalletsFromSeed(seed, walletCount)
+	return wallets
 }
 
-func RestoreWalletsFromSeed(seed []byte, walletCount uint32) [](*WalletInfo) {
-	var wallets [](*WalletInfo)
-	var i uint32 = 0
-	for ; i < walletCount; i++ {
-		wallet := GenerateWalletFromSeed(seed, i)
-		wallets = append(wallets, wallet)
+func TryGenerateWalletFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte, keyIndex uint32) (*WalletInfo, error) {
+	seed, err := TryGenerateSeedFromKeystore(keyStoreEncrypted, passwordHash)
+	if err != nil {
+		return nil, err
 	}
-	return wallets
+	return TryGenerateWalletFromSeed(seed, keyIndex)
 }
 
 func GenerateWalletFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte, keyIndex uint32) *WalletInfo {
@@ -350,6 +622,14 @@ func GenerateWalletFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte,
 	return GenerateWalletFromSeed(seed, keyIndex)
 }
 
+func TryRestoreWalletsFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte, walletCount uint32) ([](*WalletInfo), error) {
+	seed, err := TryGenerateSeedFromKeystore(keyStoreEncrypted, passwordHash)
+	if err != nil {
+		return nil, err
+	}
+	return TryRestoreWalletsFromSeed(seed, walletCount)
+}
+
 func RestoreWalletsFromKeystore(keyStoreEncrypted []byte, passwordHash [32]byte, walletCount uint32) [](*WalletInfo) {
 	seed := GenerateSeedFromKeystore(keyStoreEncrypted, passwordHash)
 	return RestoreWalletsFromSeed(seed, walletCount)
@@ -1,6 +1,10 @@
 package ownSdk
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +25,27 @@ func TestEncryption(t *testing.T) {
 	assert.Equal(t, "Chainium", string(decryptedText))
 }
 
+func TestTryDecryptWithWrongPassword(t *testing.T) {
+	var passwordHash, wrongPasswordHash [32]byte
+	copy(passwordHash[:], Decode58(Hash([]byte("pass"))))
+	copy(wrongPasswordHash[:], Decode58(Hash([]byte("wrong"))))
+	encryptedText, err := TryEncrypt([]byte("Chainium"), passwordHash)
+	assert.NoError(t, err)
+
+	decryptedText, err := TryDecrypt(encryptedText, wrongPasswordHash)
+
+	assert.Nil(t, decryptedText)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+}
+
+func TestTryDecryptWithTruncatedCiphertext(t *testing.T) {
+	var passwordHash [32]byte
+	decryptedText, err := TryDecrypt([]byte("short"), passwordHash)
+
+	assert.Nil(t, decryptedText)
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 // Encoding
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -59,6 +84,18 @@ func TestEncodeDecode58Rountrip(t *testing.T) {
 	assert.Equal(t, "Chainium", string(decoded))
 }
 
+func TestTryDecode58InvalidInput(t *testing.T) {
+	decoded, err := TryDecode58("0OIl")
+	assert.Nil(t, decoded)
+	assert.ErrorIs(t, err, ErrInvalidEncoding)
+}
+
+func TestTryDecode64InvalidInput(t *testing.T) {
+	decoded, err := TryDecode64("not base64!")
+	assert.Nil(t, decoded)
+	assert.ErrorIs(t, err, ErrInvalidEncoding)
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 // Hashing
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -79,6 +116,11 @@ func TestDeriveHash(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestTryDeriveHashInvalidAddress(t *testing.T) {
+	_, err := TryDeriveHash("CH0OIl", 1, 1)
+	assert.ErrorIs(t, err, ErrInvalidEncoding)
+}
+
 func TestIsValidBlockchainAddress(t *testing.T) {
 	inlineData := map[string]bool{
 		"CHPvS1Hxs4oLcrbgKWYYmubSBjurjUdvjg8": true,
@@ -101,6 +143,56 @@ func TestWallet(t *testing.T) {
 	wallet := GenerateWallet()
 	addressFromPrivateKey := AddressFromPrivateKey(wallet.PrivateKey)
 	assert.Equal(t, wallet.Address, addressFromPrivateKey)
+	assert.Equal(t, wallet.PublicKey, PublicKeyFromPrivateKey(wallet.PrivateKey))
+	assert.Equal(t, wallet, WalletFromPrivateKey(wallet.PrivateKey))
+}
+
+func TestAddressFromPublicKey(t *testing.T) {
+	privateKey := "B6WNNx9oK8qRUU52PpzjXHZuv4NUb3Z33hdju3hhrceS"
+	publicKey, err := TryPublicKeyFromPrivateKey(privateKey)
+	assert.NoError(t, err)
+	assert.Len(t, Decode58(publicKey), 33)
+
+	uncompressed, err := DecompressPublicKey(publicKey)
+	assert.NoError(t, err)
+
+	for _, key := range []string{publicKey, uncompressed} {
+		address, err := TryAddressFromPublicKey(key)
+		assert.NoError(t, err)
+		assert.Equal(t, AddressFromPrivateKey(privateKey), address)
+	}
+}
+
+func TestTryAddressFromPublicKeyInvalidKey(t *testing.T) {
+	publicKeyBytes := Decode58(PublicKeyFromPrivateKey("B6WNNx9oK8qRUU52PpzjXHZuv4NUb3Z33hdju3hhrceS"))
+	publicKeyBytes[0] = 5
+
+	inlineData := []string{
+		"",
+		"0OIl",
+		Encode58(publicKeyBytes),
+		Encode58(make([]byte, 65)),
+	}
+
+	for _, publicKey := range inlineData {
+		address, err := TryAddressFromPublicKey(publicKey)
+		assert.Equal(t, "", address)
+		assert.ErrorIs(t, err, ErrInvalidPublicKey)
+	}
+}
+
+func TestTryAddressFromPrivateKeyInvalidKey(t *testing.T) {
+	inlineData := []string{
+		"",
+		"0OIl",
+		Encode58([]byte("too short")),
+	}
+
+	for _, privateKey := range inlineData {
+		address, err := TryAddressFromPrivateKey(privateKey)
+		assert.Equal(t, "", address)
+		assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -132,6 +224,207 @@ func TestVerifyPlainTextSignature(t *testing.T) {
 	assert.Equal(t, expectedAddress, address)
 }
 
+func TestVerifyMessageSignature(t *testing.T) {
+	msg := "Chainium"
+	networkCode := "UNIT_TESTS"
+	wallet := GenerateWallet()
+	sig := SignMessage(networkCode, wallet.PrivateKey, msg)
+
+	address, err := VerifyMessageSignature(networkCode, sig, msg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address, address)
+}
+
+func TestVerifyMessageSignatureKnownSignature(t *testing.T) {
+	privateKey := "B6WNNx9oK8qRUU52PpzjXHZuv4NUb3Z33hdju3hhrceS"
+	sig := "6Hhxz2eP3AagR56mP4AAaKViUxHi3gM9c5weLDR48x4X4ynRBDfxsHGjhX9cni1mtCkNxbnZ783YPgMwVYV52X1w5"
+
+	address, err := VerifyMessageSignature("UNIT_TESTS", sig, "Chainium")
+
+	assert.NoError(t, err)
+	assert.Equal(t, AddressFromPrivateKey(privateKey), address)
+}
+
+func TestIsMessageSignedBy(t *testing.T) {
+	msg := "Chainium"
+	networkCode := "UNIT_TESTS"
+	wallet := GenerateWallet()
+	otherWallet := GenerateWallet()
+	sig := SignMessage(networkCode, wallet.PrivateKey, msg)
+
+	assert.True(t, IsMessageSignedBy(networkCode, sig, msg, wallet.Address))
+	assert.False(t, IsMessageSignedBy(networkCode, sig, msg, otherWallet.Address))
+	assert.False(t, IsMessageSignedBy("OTHER_NETWORK", sig, msg, wallet.Address))
+	assert.False(t, IsMessageSignedBy(networkCode, sig, "Other message", wallet.Address))
+	assert.False(t, IsMessageSignedBy(networkCode, "0OIl", msg, wallet.Address))
+}
+
+func highSSignature(sigBytes []byte) []byte {
+	highS := make([]byte, 65)
+	copy(highS, sigBytes)
+	s := new(big.Int).SetBytes(sigBytes[32:64])
+	new(big.Int).Sub(secp256k1N, s).FillBytes(highS[32:64])
+	highS[64] ^= 1
+	return highS
+}
+
+func nonCanonicalSignatures(sigBytes []byte) [][]byte {
+	badRecoveryId := make([]byte, 65)
+	copy(badRecoveryId, sigBytes)
+	badRecoveryId[64] = 27
+
+	return [][]byte{
+		highSSignature(sigBytes),
+		badRecoveryId,
+		sigBytes[:64],
+		append(append([]byte(nil), sigBytes...), 0),
+		make([]byte, 65),
+	}
+}
+
+func TestVerifyMessageSignatureRejectsNonCanonicalSignatures(t *testing.T) {
+	msg := "Chainium"
+	networkCode := "UNIT_TESTS"
+	wallet := GenerateWallet()
+	sigBytes := Decode58(SignMessage(networkCode, wallet.PrivateKey, msg))
+
+	for _, sig := range nonCanonicalSignatures(sigBytes) {
+		address, err := VerifyMessageSignature(networkCode, Encode58(sig), msg)
+		assert.Equal(t, "", address)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+		assert.False(t, IsMessageSignedBy(networkCode, Encode58(sig), msg, wallet.Address))
+	}
+
+	// The high S variant is still a mathematically valid signature by the same key.
+	address, err := recoverAddressFromSignatureBytes(messageHash(networkCode, msg), highSSignature(sigBytes))
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address, address)
+}
+
+func TestVerifyPlainTextSignatureRejectsNonCanonicalSignatures(t *testing.T) {
+	txt := "Chainium"
+	wallet := GenerateWallet()
+	sigBytes := Decode58(SignPlainText(wallet.PrivateKey, txt))
+
+	for _, sig := range nonCanonicalSignatures(sigBytes) {
+		address, err := TryVerifyPlainTextSignature(Encode58(sig), txt)
+		assert.Equal(t, "", address)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+		assert.Equal(t, "", VerifyPlainTextSignature(Encode58(sig), txt))
+	}
+}
+
+func TestSigningIsDeterministic(t *testing.T) {
+	privateKey := "3rzY3EENhYrWXzUqNnMEbGUr3iEzzSZrjMwJ1CgQpJpq"
+	networkCode := "UNIT_TESTS"
+
+	inlineData := []string{"", "Chainium", "Chainium ", strings.Repeat("x", 10000)}
+
+	for _, msg := range inlineData {
+		sig := SignMessage(networkCode, privateKey, msg)
+		assert.Equal(t, sig, SignMessage(networkCode, privateKey, msg))
+		assert.Equal(t, SignPlainText(privateKey, msg), SignPlainText(privateKey, msg))
+	}
+	assert.NotEqual(t, SignMessage(networkCode, privateKey, "a"), SignMessage(networkCode, privateKey, "b"))
+}
+
+func TestSignaturesHaveLowS(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		wallet := GenerateWallet()
+		msg := fmt.Sprintf("Chainium %d", i)
+		sigBytes := Decode58(SignMessage("UNIT_TESTS", wallet.PrivateKey, msg))
+
+		assert.NoError(t, validateSignature(sigBytes))
+		s := new(big.Int).SetBytes(sigBytes[32:64])
+		assert.True(t, s.Cmp(secp256k1HalfN) <= 0)
+	}
+}
+
+func TestNormalizeSignature(t *testing.T) {
+	wallet := GenerateWallet()
+	sigBytes := Decode58(SignMessage("UNIT_TESTS", wallet.PrivateKey, "Chainium"))
+
+	normalized := highSSignature(sigBytes)
+	normalizeSignature(normalized)
+	assert.Equal(t, sigBytes, normalized)
+
+	normalized = append([]byte(nil), sigBytes...)
+	normalizeSignature(normalized)
+	assert.Equal(t, sigBytes, normalized)
+}
+
+func TestSignBytesMatchesSignMessage(t *testing.T) {
+	privateKey := "B6WNNx9oK8qRUU52PpzjXHZuv4NUb3Z33hdju3hhrceS"
+	expectedSig := "6Hhxz2eP3AagR56mP4AAaKViUxHi3gM9c5weLDR48x4X4ynRBDfxsHGjhX9cni1mtCkNxbnZ783YPgMwVYV52X1w5"
+
+	sig, err := SignBytes("UNIT_TESTS", privateKey, []byte("Chainium"))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSig, sig)
+}
+
+func TestVerifyBytes(t *testing.T) {
+	data := []byte{0x0a, 0x00, 0xff, 0x12, 0x00}
+	networkCode := "UNIT_TESTS"
+	wallet := GenerateWallet()
+	sig, err := SignBytes(networkCode, wallet.PrivateKey, data)
+	assert.NoError(t, err)
+
+	address, err := VerifyBytes(networkCode, sig, data)
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address, address)
+
+	address, err = VerifyBytes("OTHER_NETWORK", sig, data)
+	assert.NoError(t, err)
+	assert.NotEqual(t, wallet.Address, address)
+
+	for _, sig := range nonCanonicalSignatures(Decode58(sig)) {
+		_, err := VerifyBytes(networkCode, Encode58(sig), data)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	}
+}
+
+func TestSignDigest(t *testing.T) {
+	digest := sha256.Sum256([]byte("payload from another system"))
+	wallet := GenerateWallet()
+
+	sig, err := SignDigest(wallet.PrivateKey, digest)
+	assert.NoError(t, err)
+
+	address, err := RecoverAddressFromDigest(digest, sig)
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address, address)
+
+	// The digest is signed as is, while plain text is hashed first.
+	plainTextSig, err := SignDigest(wallet.PrivateKey, xsha256([]byte("Chainium")))
+	assert.NoError(t, err)
+	assert.Equal(t, SignPlainText(wallet.PrivateKey, "Chainium"), plainTextSig)
+
+	_, err = SignDigest("0OIl", digest)
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+	_, err = RecoverAddressFromDigest(digest, Encode58(make([]byte, 65)))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestTrySignMessageInvalidKey(t *testing.T) {
+	sig, err := TrySignMessage("UNIT_TESTS", "0OIl", "Chainium")
+	assert.Equal(t, "", sig)
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+}
+
+func TestTryVerifyPlainTextSignatureInvalidSignature(t *testing.T) {
+	inlineData := []string{
+		"0OIl",
+		Encode58([]byte("not a signature")),
+	}
+
+	for _, sig := range inlineData {
+		address, err := TryVerifyPlainTextSignature(sig, "Chainium")
+		assert.Equal(t, "", address)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 // Hierarchical Deterministic Cryptography
 ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -148,3 +441,38 @@ func TestHdCrypto(t *testing.T) {
 	assert.Equal(t, expectedAddress, wallet.Address)
 	assert.Equal(t, expectedAddress, wallets[0].Address)
 }
+
+func TestTryGenerateSeedFromMnemonicInvalidMnemonic(t *testing.T) {
+	mnemonic := "receive raccoon rocket donkey cherry garbage medal skirt random smoke young before scale leave hold insect foster blouse mail donkey regular vital hurt hurt"
+	seed, err := TryGenerateSeedFromMnemonic(mnemonic, "")
+	assert.Nil(t, seed)
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+}
+
+func TestGenerateSeedFromMnemonicPanicsWithMnemonicError(t *testing.T) {
+	defer func() {
+		mnemonicErr, ok := recover().(*MnemonicError)
+		assert.True(t, ok)
+		assert.ErrorIs(t, mnemonicErr, ErrInvalidMnemonic)
+		assert.ErrorIs(t, mnemonicErr, ErrMnemonicWordCount)
+	}()
+	GenerateSeedFromMnemonic("receive raccoon rocket", "")
+}
+
+func TestTryGenerateWalletFromKeystoreWithWrongPassword(t *testing.T) {
+	mnemonic, err := TryGenerateMnemonic()
+	assert.NoError(t, err)
+	var passwordHash, wrongPasswordHash [32]byte
+	copy(passwordHash[:], Decode58(Hash([]byte("pass"))))
+	copy(wrongPasswordHash[:], Decode58(Hash([]byte("wrong"))))
+	keystore, err := TryGenerateKeystore(mnemonic, passwordHash)
+	assert.NoError(t, err)
+
+	wallet, err := TryGenerateWalletFromKeystore(keystore, passwordHash, 0)
+	assert.NoError(t, err)
+	assert.True(t, IsValidBlockchainAddress(wallet.Address))
+
+	wallet, err = TryGenerateWalletFromKeystore(keystore, wrongPasswordHash, 0)
+	assert.Nil(t, wallet)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+}
@@ -0,0 +1,264 @@
+package ownSdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip32"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DerivationPath is a BIP32 key path. Hardened indexes include HardenedKeyStart.
+type DerivationPath []uint32
+
+const (
+	HardenedKeyStart uint32 = bip32.FirstHardenedChild
+	Bip44Purpose     uint32 = 44
+	ChxCoinType      uint32 = 25718
+)
+
+// BIP44 chains: external for receiving addresses, internal for change addresses.
+const (
+	ExternalChain uint32 = 0
+	InternalChain uint32 = 1
+)
+
+var (
+	ErrInvalidDerivationPath = errors.New("invalid derivation path")
+	ErrInvalidExtendedKey    = errors.New("invalid extended public key")
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructors
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TryNewDerivationPath returns the BIP44 path m/44'/25718'/{account}'/{chain}/{index}.
+// The account must be lower than HardenedKeyStart, since it is hardened by adding HardenedKeyStart.
+func TryNewDerivationPath(account uint32, chain uint32, index uint32) (DerivationPath, error) {
+	if account >= HardenedKeyStart {
+		return nil, fmt.Errorf("%w: account %d exceeds %d", ErrInvalidDerivationPath, account, HardenedKeyStart-1)
+	}
+	path := DerivationPath{
+		HardenedKeyStart + Bip44Purpose,
+		HardenedKeyStart + ChxCoinType,
+		HardenedKeyStart + account,
+		chain,
+		index,
+	}
+	return path, nil
+}
+
+// NewDerivationPath is like TryNewDerivationPath, but panics if the account is invalid.
+func NewDerivationPath(account uint32, chain uint32, index uint32) DerivationPath {
+	path, err := TryNewDerivationPath(account, chain, index)
+	if err != nil {
+		panic(err)
+	}
+	return path
+}
+
+// ParseDerivationPath parses paths like m/44'/25718'/3'/0/7. Hardened indexes may be marked
+// with ', h or H.
+func ParseDerivationPath(s string) (DerivationPath, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("%w: %q must start with m/", ErrInvalidDerivationPath, s)
+	}
+
+	path := make(DerivationPath, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := false
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H") {
+			hardened = true
+			part = part[:len(part)-1]
+		}
+
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || index >= uint64(HardenedKeyStart) || strings.HasPrefix(part, "+") {
+			return nil, fmt.Errorf("%w: invalid index %q in %q", ErrInvalidDerivationPath, part, s)
+		}
+		if hardened {
+			index += uint64(HardenedKeyStart)
+		}
+		path = append(path, uint32(index))
+	}
+
+	return path, nil
+}
+
+func MustParseDerivationPath(s string) DerivationPath {
+	path, err := ParseDerivationPath(s)
+	if err != nil {
+		panic(err)
+	}
+	return path
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Formatting
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (p DerivationPath) String() string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, index := range p {
+		sb.WriteString("/")
+		if index >= HardenedKeyStart {
+			sb.WriteString(strconv.FormatUint(uint64(index-HardenedKeyStart), 10))
+			sb.WriteString("'")
+		} else {
+			sb.WriteString(strconv.FormatUint(uint64(index), 10))
+		}
+	}
+	return sb.String()
+}
+
+func (p DerivationPath) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *DerivationPath) UnmarshalText(text []byte) error {
+	path, err := ParseDerivationPath(string(text))
+	if err != nil {
+		return err
+	}
+	*p = path
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Derivation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func deriveKeyAtPath(masterKey *bip32.Key, path DerivationPath) (*bip32.Key, error) {
+	key := masterKey
+	for _, index := range path {
+		child, err := key.NewChildKey(index)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidDerivationPath, path, err)
+		}
+		key = child
+	}
+	return key, nil
+}
+
+func privateKeyFromSeedAtPath(seed []byte, path DerivationPath) ([]byte, error) {
+	masterKey, err := generateMasterKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	childKey, err := deriveKeyAtPath(masterKey, path)
+	if err != nil {
+		return nil, err
+	}
+	return childKey.Key, nil
+}
+
+func TryGenerateWalletFromSeedAtPath(seed []byte, path DerivationPath) (*WalletInfo, error) {
+	privateKeyBytes, err := privateKeyFromSeedAtPath(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := TryWalletFromPrivateKey(Encode58(privateKeyBytes))
+	if err != nil {
+		return nil, err
+	}
+	wallet.DerivationPath = append(DerivationPath(nil), path...)
+	return wallet, nil
+}
+
+func GenerateWalletFromSeedAtPath(seed []byte, path DerivationPath) *WalletInfo {
+	wallet, _ := TryGenerateWalletFromSeedAtPath(seed, path)
+	return wallet
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Extended Public Keys
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ExtendedPublicKeyFromSeed returns the xpub of the account m/44'/25718'/{account}', from which
+// the account's addresses can be derived without the seed.
+func ExtendedPublicKeyFromSeed(seed []byte, account uint32) (string, error) {
+	masterKey, err := generateMasterKeyFromSeed(seed)
+	if err != nil {
+		return "", err
+	}
+	path, err := TryNewDerivationPath(account, ExternalChain, 0)
+	if err != nil {
+		return "", err
+	}
+	accountKey, err := deriveKeyAtPath(masterKey, path[:3])
+	if err != nil {
+		return "", err
+	}
+	return accountKey.PublicKey().B58Serialize(), nil
+}
+
+func parseExtendedPublicKey(xpub string) (*bip32.Key, error) {
+	key, err := bip32.B58Deserialize(xpub)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExtendedKey, err)
+	}
+	if key.IsPrivate || !bytes.Equal(key.Version, bip32.PublicWalletVersion) {
+		return nil, fmt.Errorf("%w: not an extended public key", ErrInvalidExtendedKey)
+	}
+	if _, err := crypto.DecompressPubkey(key.Key); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExtendedKey, err)
+	}
+	return key, nil
+}
+
+func addressFromPublicChildKey(chainKey *bip32.Key, index uint32) (string, error) {
+	childKey, err := deriveKeyAtPath(chainKey, DerivationPath{index})
+	if err != nil {
+		return "", err
+	}
+	publicKey, err := crypto.DecompressPubkey(childKey.Key)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidExtendedKey, err)
+	}
+	return blockchainAddress(crypto.FromECDSAPub(publicKey)), nil
+}
+
+// AddressesFromExtendedPublicKey derives count addresses on the given chain of an account xpub,
+// starting at index.
+func AddressesFromExtendedPublicKey(xpub string, chain uint32, index uint32, count uint32) ([]string, error) {
+	if uint64(index)+uint64(count) > uint64(HardenedKeyStart) {
+		return nil, fmt.Errorf("%w: index range exceeds %d", ErrInvalidDerivationPath, HardenedKeyStart-1)
+	}
+	accountKey, err := parseExtendedPublicKey(xpub)
+	if err != nil {
+		return nil, err
+	}
+	chainKey, err := deriveKeyAtPath(accountKey, DerivationPath{chain})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, count)
+	for i := index; i < index+count; i++ {
+		address, err := addressFromPublicChildKey(chainKey, i)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// AddressFromExtendedPublicKey derives the receiving address at index (chain 0) of an account xpub.
+// It matches the address of GenerateWalletFromSeedAtPath(seed, NewDerivationPath(account, 0, index)).
+func AddressFromExtendedPublicKey(xpub string, index uint32) (string, error) {
+	addresses, err := AddressesFromExtendedPublicKey(xpub, ExternalChain, index, 1)
+	if err != nil {
+		return "", err
+	}
+	return addresses[0], nil
+}
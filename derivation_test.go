@@ -0,0 +1,185 @@
+package ownSdk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Parsing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestParseDerivationPath(t *testing.T) {
+	inlineData := map[string]DerivationPath{
+		"m":                    {},
+		"m/44'/25718'/0'/0/0":  NewDerivationPath(0, ExternalChain, 0),
+		"m/44'/25718'/3'/1/7":  NewDerivationPath(3, InternalChain, 7),
+		"m/44h/25718H/3h/0/7":  NewDerivationPath(3, ExternalChain, 7),
+		"m/0/2147483647'":      {0, 0xFFFFFFFF},
+		" m/44'/25718'/0'/0 ":  {HardenedKeyStart + 44, HardenedKeyStart + 25718, HardenedKeyStart, 0},
+		"m/44'/60'/0'/0/12345": {HardenedKeyStart + 44, HardenedKeyStart + 60, HardenedKeyStart, 0, 12345},
+	}
+
+	for s, expectedPath := range inlineData {
+		path, err := ParseDerivationPath(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expectedPath, path, s)
+	}
+}
+
+func TestParseDerivationPathInvalid(t *testing.T) {
+	inlineData := []string{
+		"",
+		"44'/25718'",
+		"m/",
+		"m//0",
+		"m/-1",
+		"m/+1",
+		"m/x",
+		"m/2147483648",
+		"m/0''",
+	}
+
+	for _, s := range inlineData {
+		_, err := ParseDerivationPath(s)
+		assert.ErrorIs(t, err, ErrInvalidDerivationPath, s)
+	}
+}
+
+func TestNewDerivationPathInvalidAccount(t *testing.T) {
+	path, err := TryNewDerivationPath(HardenedKeyStart-1, ExternalChain, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0xFFFFFFFF), path[2])
+
+	path, err = TryNewDerivationPath(HardenedKeyStart, ExternalChain, 0)
+	assert.Nil(t, path)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+	assert.Panics(t, func() { NewDerivationPath(HardenedKeyStart+5, ExternalChain, 0) })
+
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	_, err = ExtendedPublicKeyFromSeed(seed, HardenedKeyStart)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+	_, err = NewWalletDeriver(seed, HardenedKeyStart, ExternalChain)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+}
+
+func TestDerivationPathString(t *testing.T) {
+	inlineData := []string{
+		"m",
+		"m/44'/25718'/0'/0/0",
+		"m/44'/25718'/3'/1/7",
+		"m/0/2147483647'",
+	}
+
+	for _, s := range inlineData {
+		assert.Equal(t, s, MustParseDerivationPath(s).String())
+	}
+}
+
+func TestDerivationPathJson(t *testing.T) {
+	wallet := &WalletInfo{Address: "CHxxx", DerivationPath: NewDerivationPath(1, InternalChain, 2)}
+
+	b, err := json.Marshal(wallet)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"DerivationPath":"m/44'/25718'/1'/1/2"`)
+
+	var decoded WalletInfo
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, wallet.DerivationPath, decoded.DerivationPath)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Derivation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestGenerateWalletFromSeedAtPath(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+
+	defaultWallet, err := TryGenerateWalletFromSeed(seed, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "m/44'/25718'/0'/0/7", defaultWallet.DerivationPath.String())
+
+	wallet := GenerateWalletFromSeedAtPath(seed, MustParseDerivationPath("m/44'/25718'/0'/0/7"))
+	assert.Equal(t, defaultWallet, wallet)
+
+	accountWallet := GenerateWalletFromSeedAtPath(seed, NewDerivationPath(3, ExternalChain, 7))
+	changeWallet := GenerateWalletFromSeedAtPath(seed, NewDerivationPath(0, InternalChain, 7))
+	assert.NotEqual(t, wallet.Address, accountWallet.Address)
+	assert.NotEqual(t, wallet.Address, changeWallet.Address)
+	assert.NotEqual(t, accountWallet.Address, changeWallet.Address)
+	assert.Equal(t, NewDerivationPath(3, ExternalChain, 7), accountWallet.DerivationPath)
+}
+
+func TestGenerateWalletFromSeedAtPathMatchesKnownAddress(t *testing.T) {
+	seed := GenerateSeedFromMnemonic("receive raccoon rocket donkey cherry garbage medal skirt random smoke young before scale leave hold insect foster blouse mail donkey regular vital hurt april", "")
+
+	wallet, err := TryGenerateWalletFromSeedAtPath(seed, MustParseDerivationPath("m/44'/25718'/0'/0/0"))
+	assert.NoError(t, err)
+	assert.Equal(t, "CHb5Z6Za34nv28Z3rLZ2Yd8LFikHaTqLhxB", wallet.Address)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Extended Public Keys
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestAddressFromExtendedPublicKeyMatchesSeedWallets(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+
+	for _, account := range []uint32{0, 5} {
+		xpub, err := ExtendedPublicKeyFromSeed(seed, account)
+		assert.NoError(t, err)
+		assert.True(t, len(xpub) > 4 && xpub[:4] == "xpub", xpub)
+
+		for index := uint32(0); index < 5; index++ {
+			address, err := AddressFromExtendedPublicKey(xpub, index)
+			assert.NoError(t, err)
+			assert.Equal(t, GenerateWalletFromSeedAtPath(seed, NewDerivationPath(account, ExternalChain, index)).Address, address)
+		}
+
+		changeAddresses, err := AddressesFromExtendedPublicKey(xpub, InternalChain, 10, 3)
+		assert.NoError(t, err)
+		for i, address := range changeAddresses {
+			expectedWallet := GenerateWalletFromSeedAtPath(seed, NewDerivationPath(account, InternalChain, uint32(10+i)))
+			assert.Equal(t, expectedWallet.Address, address)
+		}
+	}
+}
+
+func TestAddressFromExtendedPublicKeyInvalidKey(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	masterKey, err := generateMasterKeyFromSeed(seed)
+	assert.NoError(t, err)
+
+	inlineData := []string{
+		"",
+		"xpub123",
+		masterKey.B58Serialize(), // xprv
+	}
+
+	for _, xpub := range inlineData {
+		address, err := AddressFromExtendedPublicKey(xpub, 0)
+		assert.Empty(t, address)
+		assert.ErrorIs(t, err, ErrInvalidExtendedKey, xpub)
+	}
+}
+
+func TestAddressesFromExtendedPublicKeyHardenedIndex(t *testing.T) {
+	xpub, err := ExtendedPublicKeyFromSeed(GenerateSeedFromMnemonic(keystoreTestMnemonic, ""), 0)
+	assert.NoError(t, err)
+
+	_, err = AddressFromExtendedPublicKey(xpub, HardenedKeyStart)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+
+	_, err = AddressFromExtendedPublicKey(xpub, 0xFFFFFFFF)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+
+	addresses, err := AddressesFromExtendedPublicKey(xpub, ExternalChain, HardenedKeyStart-2, 3)
+	assert.Nil(t, addresses)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+
+	addresses, err = AddressesFromExtendedPublicKey(xpub, ExternalChain, HardenedKeyStart-2, 2)
+	assert.NoError(t, err)
+	assert.Len(t, addresses, 2)
+}
@@ -0,0 +1,148 @@
+package ownSdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"runtime"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WalletDeriver derives wallets on one BIP44 chain (m/44'/25718'/{account}'/{chain}).
+// The chain node is derived once, so each wallet costs a single child derivation.
+// It is safe for concurrent use.
+type WalletDeriver struct {
+	chainPath DerivationPath
+	chainCode []byte
+	chainKey  *big.Int
+	publicKey []byte // Compressed public key of the chain node
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructor
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func NewWalletDeriver(seed []byte, account uint32, chain uint32) (*WalletDeriver, error) {
+	masterKey, err := generateMasterKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := TryNewDerivationPath(account, chain, 0)
+	if err != nil {
+		return nil, err
+	}
+	chainPath := path[:4]
+	chainKey, err := deriveKeyAtPath(masterKey, chainPath)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := crypto.ToECDSA(chainKey.Key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+
+	deriver := &WalletDeriver{
+		chainPath: chainPath,
+		chainCode: chainKey.ChainCode,
+		chainKey:  privateKey.D,
+		publicKey: crypto.CompressPubkey(&privateKey.PublicKey),
+	}
+	return deriver, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Derivation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// childPrivateKey implements BIP32 CKDpriv for a non-hardened index.
+func (d *WalletDeriver) childPrivateKey(index uint32) ([]byte, error) {
+	if index >= HardenedKeyStart {
+		return nil, fmt.Errorf("%w: hardened index %d", ErrInvalidDerivationPath, index)
+	}
+
+	data := make([]byte, len(d.publicKey)+4)
+	copy(data, d.publicKey)
+	binary.BigEndian.PutUint32(data[len(d.publicKey):], index)
+
+	mac := hmac.New(sha512.New, d.chainCode)
+	mac.Write(data)
+	intermediary := mac.Sum(nil)
+
+	childKey := new(big.Int).SetBytes(intermediary[:32])
+	if childKey.Cmp(secp256k1N) >= 0 {
+		return nil, fmt.Errorf("%w: invalid child key at index %d", ErrInvalidDerivationPath, index)
+	}
+	childKey.Add(childKey, d.chainKey)
+	childKey.Mod(childKey, secp256k1N)
+	if childKey.Sign() == 0 {
+		return nil, fmt.Errorf("%w: invalid child key at index %d", ErrInvalidDerivationPath, index)
+	}
+
+	return childKey.FillBytes(make([]byte, 32)), nil
+}
+
+func (d *WalletDeriver) Wallet(index uint32) (*WalletInfo, error) {
+	privateKey, err := d.childPrivateKey(index)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(privateKey)
+
+	wallet, err := walletFromPrivateKeyBytes(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	path := make(DerivationPath, len(d.chainPath), len(d.chainPath)+1)
+	copy(path, d.chainPath)
+	wallet.DerivationPath = append(path, index)
+	return wallet, nil
+}
+
+// Wallets derives count wallets starting at index, spreading the work over GOMAXPROCS goroutines.
+// The result is ordered by index.
+func (d *WalletDeriver) Wallets(index uint32, count uint32) ([](*WalletInfo), error) {
+	if uint64(index)+uint64(count) > uint64(HardenedKeyStart) {
+		return nil, fmt.Errorf("%w: index range exceeds %d", ErrInvalidDerivationPath, HardenedKeyStart-1)
+	}
+
+	wallets := make([](*WalletInfo), count)
+	workers := runtime.GOMAXPROCS(0)
+	if workers > int(count) {
+		workers = int(count)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := worker; i < int(count); i += workers {
+				wallet, err := d.Wallet(index + uint32(i))
+				if err != nil {
+					errs[worker] = err
+					return
+				}
+				wallets[i] = wallet
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return wallets, nil
+}
@@ -0,0 +1,95 @@
+package ownSdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletDeriverMatchesGenerateWalletFromSeed(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+
+	for _, account := range []uint32{0, 2} {
+		for _, chain := range []uint32{ExternalChain, InternalChain} {
+			deriver, err := NewWalletDeriver(seed, account, chain)
+			assert.NoError(t, err)
+
+			wallets, err := deriver.Wallets(5, 20)
+			assert.NoError(t, err)
+			assert.Len(t, wallets, 20)
+			for i, wallet := range wallets {
+				expectedWallet := GenerateWalletFromSeedAtPath(seed, NewDerivationPath(account, chain, uint32(5+i)))
+				assert.Equal(t, expectedWallet, wallet)
+			}
+		}
+	}
+}
+
+func TestRestoreWalletsFromSeedUsesDeriver(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+
+	wallets, err := TryRestoreWalletsFromSeed(seed, 20)
+	assert.NoError(t, err)
+	for i, wallet := range wallets {
+		assert.Equal(t, GenerateWalletFromSeed(seed, uint32(i)), wallet)
+	}
+
+	wallets, err = TryRestoreWalletsFromSeed(seed, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, wallets)
+}
+
+func TestWalletDeriverRejectsHardenedIndexes(t *testing.T) {
+	deriver, err := NewWalletDeriver(GenerateSeedFromMnemonic(keystoreTestMnemonic, ""), 0, ExternalChain)
+	assert.NoError(t, err)
+
+	_, err = deriver.Wallet(HardenedKeyStart)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+
+	_, err = deriver.Wallets(HardenedKeyStart-1, 2)
+	assert.ErrorIs(t, err, ErrInvalidDerivationPath)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Benchmarks
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const benchmarkWalletCount = 100
+
+func BenchmarkRestoreWalletsOneByOne(b *testing.B) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := uint32(0); i < benchmarkWalletCount; i++ {
+			if _, err := TryGenerateWalletFromSeed(seed, i); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkWalletDeriverSequential(b *testing.B) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		deriver, err := NewWalletDeriver(seed, 0, ExternalChain)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for i := uint32(0); i < benchmarkWalletCount; i++ {
+			if _, err := deriver.Wallet(i); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkWalletDeriverParallel(b *testing.B) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := TryRestoreWalletsFromSeed(seed, benchmarkWalletCount); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package ownSdk
+
+import (
+	"context"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AddressInfoProvider is the part of the node API used by DiscoverWallets. *Client implements it.
+type AddressInfoProvider interface {
+	GetAddressInfo(ctx context.Context, address string) (*AddressInfoDto, error)
+}
+
+const DefaultDiscoveryGapLimit = 20
+
+type DiscoveryOptions struct {
+	Account uint32 // First account to scan
+	// GapLimit is the number of consecutive unused addresses after which a chain is considered
+	// exhausted. Defaults to DefaultDiscoveryGapLimit.
+	GapLimit uint32
+	// ScanInternalChain also scans the change chain (m/44'/25718'/{account}'/1) of each account.
+	ScanInternalChain bool
+	// ScanAccounts continues with the next account as long as the previous one had used addresses,
+	// as described in BIP44. Otherwise only Account is scanned.
+	ScanAccounts bool
+}
+
+type DiscoveredWallet struct {
+	Wallet  *WalletInfo
+	Nonce   int64
+	Balance ChxBalanceInfoDto
+}
+
+func (o *DiscoveryOptions) withDefaults() DiscoveryOptions {
+	options := DiscoveryOptions{}
+	if o != nil {
+		options = *o
+	}
+	if options.GapLimit == 0 {
+		options.GapLimit = DefaultDiscoveryGapLimit
+	}
+	return options
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Discovery
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func isAddressUsed(addressInfo *AddressInfoDto) bool {
+	balance := addressInfo.Balance
+	return addressInfo.Nonce > 0 ||
+		!balance.Total.IsZero() ||
+		!balance.Staked.IsZero() ||
+		!balance.Deposit.IsZero() ||
+		!balance.Available.IsZero()
+}
+
+func discoverChain(ctx context.Context, node AddressInfoProvider, deriver *WalletDeriver, gapLimit uint32) ([](*DiscoveredWallet), error) {
+	discovered := make([](*DiscoveredWallet), 0)
+	gap := uint32(0)
+	for index := uint32(0); gap < gapLimit && index < HardenedKeyStart; index++ {
+		wallet, err := deriver.Wallet(index)
+		if err != nil {
+			return nil, err
+		}
+
+		addressInfo, err := node.GetAddressInfo(ctx, wallet.Address)
+		if IsNotFoundError(err) {
+			gap++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !isAddressUsed(addressInfo) {
+			gap++
+			continue
+		}
+		gap = 0
+		discovered = append(discovered, &DiscoveredWallet{
+			Wallet:  wallet,
+			Nonce:   addressInfo.Nonce,
+			Balance: addressInfo.Balance,
+		})
+	}
+	return discovered, nil
+}
+
+// DiscoverWallets restores the wallets used on chain from a seed, without knowing their count.
+// Addresses are derived one by one and looked up on the node; scanning a chain stops after
+// GapLimit consecutive addresses without nonce or balance.
+func DiscoverWallets(ctx context.Context, node AddressInfoProvider, seed []byte, opts *DiscoveryOptions) ([](*DiscoveredWallet), error) {
+	options := opts.withDefaults()
+
+	chains := []uint32{ExternalChain}
+	if options.ScanInternalChain {
+		chains = append(chains, InternalChain)
+	}
+
+	discovered := make([](*DiscoveredWallet), 0)
+	for account := options.Account; account < HardenedKeyStart; account++ {
+		accountDiscovered := make([](*DiscoveredWallet), 0)
+		for _, chain := range chains {
+			deriver, err := NewWalletDeriver(seed, account, chain)
+			if err != nil {
+				return nil, err
+			}
+			chainDiscovered, err := discoverChain(ctx, node, deriver, options.GapLimit)
+			if err != nil {
+				return nil, err
+			}
+			accountDiscovered = append(accountDiscovered, chainDiscovered...)
+		}
+
+		discovered = append(discovered, accountDiscovered...)
+		if !options.ScanAccounts || len(accountDiscovered) == 0 {
+			break
+		}
+	}
+
+	return discovered, nil
+}
@@ -0,0 +1,102 @@
+package ownSdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDiscoveryTestNode(t *testing.T, seed []byte, used map[string]string) *Client {
+	routes := make(map[string]string)
+	for path, addressInfo := range used {
+		wallet := GenerateWalletFromSeedAtPath(seed, MustParseDerivationPath(path))
+		routes["GET /address/"+wallet.Address] = addressInfo
+	}
+	return newTestNode(t, routes)
+}
+
+func TestDiscoverWalletsStopsAtGapLimit(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	client := newDiscoveryTestNode(t, seed, map[string]string{
+		"m/44'/25718'/0'/0/0": `{"nonce": 3, "balance": {"total": 10, "available": 10}}`,
+		"m/44'/25718'/0'/0/1": `{"nonce": 0, "balance": {}}`,
+		"m/44'/25718'/0'/0/4": `{"nonce": 0, "balance": {"total": 0.5, "available": 0.5}}`,
+		"m/44'/25718'/0'/0/9": `{"nonce": 1, "balance": {}}`, // Beyond the gap limit
+	})
+
+	discovered, err := DiscoverWallets(context.Background(), client, seed, &DiscoveryOptions{GapLimit: 4})
+	assert.NoError(t, err)
+	assert.Len(t, discovered, 2)
+
+	assert.Equal(t, "m/44'/25718'/0'/0/0", discovered[0].Wallet.DerivationPath.String())
+	assert.Equal(t, int64(3), discovered[0].Nonce)
+	assert.Equal(t, MustParseAmount("10"), discovered[0].Balance.Available)
+
+	assert.Equal(t, GenerateWalletFromSeed(seed, 4), discovered[1].Wallet)
+	assert.Equal(t, MustParseAmount("0.5"), discovered[1].Balance.Total)
+}
+
+func TestDiscoverWalletsScansAccountsAndChangeChain(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	client := newDiscoveryTestNode(t, seed, map[string]string{
+		"m/44'/25718'/0'/0/2": `{"nonce": 1, "balance": {}}`,
+		"m/44'/25718'/0'/1/0": `{"nonce": 0, "balance": {"deposit": 5}}`,
+		"m/44'/25718'/1'/1/1": `{"nonce": 2, "balance": {}}`,
+		"m/44'/25718'/3'/0/0": `{"nonce": 2, "balance": {}}`, // After an unused account
+	})
+
+	options := &DiscoveryOptions{GapLimit: 3, ScanInternalChain: true, ScanAccounts: true}
+	discovered, err := DiscoverWallets(context.Background(), client, seed, options)
+	assert.NoError(t, err)
+
+	paths := make([]string, 0)
+	for _, wallet := range discovered {
+		paths = append(paths, wallet.Wallet.DerivationPath.String())
+	}
+	assert.Equal(t, []string{"m/44'/25718'/0'/0/2", "m/44'/25718'/0'/1/0", "m/44'/25718'/1'/1/1"}, paths)
+}
+
+// wrappingAddressInfoProvider wraps the errors of the node, like a caching or retrying decorator.
+type wrappingAddressInfoProvider struct {
+	node AddressInfoProvider
+}
+
+func (p wrappingAddressInfoProvider) GetAddressInfo(ctx context.Context, address string) (*AddressInfoDto, error) {
+	addressInfo, err := p.node.GetAddressInfo(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("address %s: %w", address, err)
+	}
+	return addressInfo, nil
+}
+
+func TestDiscoverWalletsWithWrappedNotFoundErrors(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(keystoreTestMnemonic, "")
+	client := newDiscoveryTestNode(t, seed, map[string]string{
+		"m/44'/25718'/0'/0/1": `{"nonce": 1, "balance": {}}`,
+	})
+
+	discovered, err := DiscoverWallets(context.Background(), wrappingAddressInfoProvider{client}, seed, &DiscoveryOptions{GapLimit: 3})
+	assert.NoError(t, err)
+	assert.Len(t, discovered, 1)
+	assert.Equal(t, GenerateWalletFromSeed(seed, 1), discovered[0].Wallet)
+}
+
+type failingAddressInfoProvider struct {
+	calls int
+}
+
+func (p *failingAddressInfoProvider) GetAddressInfo(ctx context.Context, address string) (*AddressInfoDto, error) {
+	p.calls++
+	return nil, fmt.Errorf("connection refused")
+}
+
+func TestDiscoverWalletsReturnsNodeErrors(t *testing.T) {
+	node := &failingAddressInfoProvider{}
+	discovered, err := DiscoverWallets(context.Background(), node, GenerateSeedFromMnemonic(keystoreTestMnemonic, ""), nil)
+
+	assert.Nil(t, discovered)
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 1, node.calls)
+}
@@ -0,0 +1,236 @@
+package ownSdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const UnsignedTxEnvelopeVersion = 1
+
+// UnsignedTxEnvelope carries a transaction to an offline machine for signing.
+// DerivedHashes are checked against Tx when the envelope is decoded. Summary is informational:
+// it is always recomputed from Tx, so a tampered or outdated summary is never shown.
+type UnsignedTxEnvelope struct {
+	Version       int              `json:"version"`
+	NetworkCode   string           `json:"networkCode"`
+	Tx            *Tx              `json:"tx"`
+	DerivedHashes []DerivedHashDto `json:"derivedHashes"`
+	Summary       []string         `json:"summary"`
+}
+
+// DerivedHashDto is the hash of an asset or account created by the action with the given number.
+type DerivedHashDto struct {
+	ActionNumber int16      `json:"actionNumber"`
+	ActionType   ActionType `json:"actionType"`
+	Hash         string     `json:"hash"`
+}
+
+var (
+	ErrInvalidEnvelope  = errors.New("invalid unsigned tx envelope")
+	ErrEnvelopeMismatch = errors.New("signed transaction does not match envelope")
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructor
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func NewUnsignedTxEnvelope(networkCode string, tx *Tx) (*UnsignedTxEnvelope, error) {
+	if networkCode == "" {
+		return nil, fmt.Errorf("%w: network code is required", ErrInvalidEnvelope)
+	}
+
+	derivedHashes, err := txDerivedHashes(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := &UnsignedTxEnvelope{
+		Version:       UnsignedTxEnvelopeVersion,
+		NetworkCode:   networkCode,
+		Tx:            tx,
+		DerivedHashes: derivedHashes,
+		Summary:       txSummary(tx),
+	}
+
+	return envelope, nil
+}
+
+func txDerivedHashes(tx *Tx) ([]DerivedHashDto, error) {
+	derivedHashes := make([]DerivedHashDto, 0)
+	for i, action := range tx.Actions {
+		if action.ActionType != ActionTypeCreateAsset && action.ActionType != ActionTypeCreateAccount {
+			continue
+		}
+		actionNumber := int16(i + 1)
+		hash, err := TryDeriveHash(tx.SenderAddress, tx.Nonce, actionNumber)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid sender address: %v", ErrInvalidEnvelope, err)
+		}
+		derivedHashes = append(derivedHashes, DerivedHashDto{
+			ActionNumber: actionNumber,
+			ActionType:   action.ActionType,
+			Hash:         hash,
+		})
+	}
+	return derivedHashes, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Summary
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func txSummary(tx *Tx) []string {
+	summary := make([]string, 0, len(tx.Actions)+1)
+	summary = append(summary, fmt.Sprintf("Sender %s, nonce %d, action fee %s CHX, %d action(s)",
+		tx.SenderAddress, tx.Nonce, tx.ActionFee, len(tx.Actions)))
+
+	for i, action := range tx.Actions {
+		summary = append(summary, fmt.Sprintf("%d. %s", i+1, describeTxAction(tx, i, action)))
+	}
+	return summary
+}
+
+func describeTxAction(tx *Tx, i int, action TxAction) string {
+	switch dto := action.ActionData.(type) {
+	case TransferChxTxActionDto:
+		return fmt.Sprintf("Transfer %s CHX to %s", dto.Amount, dto.RecipientAddress)
+	case DelegateStakeTxActionDto:
+		return fmt.Sprintf("Delegate %s CHX stake to validator %s", dto.Amount, dto.ValidatorAddress)
+	case ConfigureValidatorTxActionDto:
+		return fmt.Sprintf("Configure validator at %s with %v%% shared reward, enabled: %t",
+			dto.NetworkAddress, dto.SharedRewardPercent, dto.IsEnabled)
+	case RemoveValidatorTxActionDto:
+		return "Remove validator " + tx.SenderAddress
+	case TransferAssetTxActionDto:
+		return fmt.Sprintf("Transfer %s of asset %s from account %s to account %s",
+			dto.Amount, dto.AssetHash, dto.FromAccountHash, dto.ToAccountHash)
+	case CreateAssetEmissionTxActionDto:
+		return fmt.Sprintf("Emit %s of asset %s to account %s", dto.Amount, dto.AssetHash, dto.EmissionAccountHash)
+	case CreateAssetTxActionDto:
+		return "Create asset " + DeriveHash(tx.SenderAddress, tx.Nonce, int16(i+1))
+	case SetAssetCodeTxActionDto:
+		return fmt.Sprintf("Set code of asset %s to %q", dto.AssetHash, dto.AssetCode)
+	case SetAssetControllerTxActionDto:
+		return fmt.Sprintf("Set controller of asset %s to %s", dto.AssetHash, dto.ControllerAddress)
+	case CreateAccountTxActionDto:
+		return "Create account " + DeriveHash(tx.SenderAddress, tx.Nonce, int16(i+1))
+	case SetAccountControllerTxActionDto:
+		return fmt.Sprintf("Set controller of account %s to %s", dto.AccountHash, dto.ControllerAddress)
+	case SubmitVoteTxActionDto:
+		return fmt.Sprintf("Vote %s on resolution %s of asset %s from account %s",
+			dto.VoteHash, dto.ResolutionHash, dto.AssetHash, dto.AccountHash)
+	case SubmitVoteWeightTxActionDto:
+		return fmt.Sprintf("Set vote weight %s on resolution %s of asset %s for account %s",
+			dto.VoteWeight, dto.ResolutionHash, dto.AssetHash, dto.AccountHash)
+	case SetAccountEligibilityTxActionDto:
+		return fmt.Sprintf("Set eligibility of account %s for asset %s: primary %t, secondary %t",
+			dto.AccountHash, dto.AssetHash, dto.IsPrimaryEligible, dto.IsSecondaryEligible)
+	case SetAssetEligibilityTxActionDto:
+		return fmt.Sprintf("Set eligibility required for asset %s: %t", dto.AssetHash, dto.IsEligibilityRequired)
+	case ChangeKycControllerAddressTxActionDto:
+		return fmt.Sprintf("Change KYC controller of account %s for asset %s to %s",
+			dto.AccountHash, dto.AssetHash, dto.KycControllerAddress)
+	case AddKycProviderTxActionDto:
+		return fmt.Sprintf("Add KYC provider %s for asset %s", dto.ProviderAddress, dto.AssetHash)
+	case RemoveKycProviderTxActionDto:
+		return fmt.Sprintf("Remove KYC provider %s for asset %s", dto.ProviderAddress, dto.AssetHash)
+	default:
+		return fmt.Sprintf("%s %s", action.ActionType, toJson(action.ActionData, false))
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Serialization
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (envelope *UnsignedTxEnvelope) ToJson(indentation bool) string {
+	return toJson(envelope, indentation)
+}
+
+// Encode returns the envelope as a single base64 string, convenient for QR codes and copy/paste.
+func (envelope *UnsignedTxEnvelope) Encode() string {
+	return Encode64([]byte(envelope.ToJson(false)))
+}
+
+func ParseUnsignedTxEnvelope(envelopeJson string) (*UnsignedTxEnvelope, error) {
+	var raw struct {
+		Version       int              `json:"version"`
+		NetworkCode   string           `json:"networkCode"`
+		Tx            json.RawMessage  `json:"tx"`
+		DerivedHashes []DerivedHashDto `json:"derivedHashes"`
+		Summary       []string         `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(envelopeJson), &raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+	if raw.Version != UnsignedTxEnvelopeVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidEnvelope, raw.Version)
+	}
+	if len(raw.Tx) == 0 {
+		return nil, fmt.Errorf("%w: tx is required", ErrInvalidEnvelope)
+	}
+
+	tx, err := ParseTx(string(raw.Tx))
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := NewUnsignedTxEnvelope(raw.NetworkCode, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !reflect.DeepEqual(envelope.DerivedHashes, raw.DerivedHashes) {
+		return nil, fmt.Errorf("%w: derived hashes do not match tx", ErrInvalidEnvelope)
+	}
+
+	return envelope, nil
+}
+
+func DecodeUnsignedTxEnvelope(encoded string) (*UnsignedTxEnvelope, error) {
+	envelopeJson, err := TryDecode64(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+	return ParseUnsignedTxEnvelope(string(envelopeJson))
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Signing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (envelope *UnsignedTxEnvelope) Sign(privateKey string) (*SignedTx, error) {
+	return envelope.Tx.TrySign(envelope.NetworkCode, privateKey)
+}
+
+func (envelope *UnsignedTxEnvelope) SignWith(signer Signer) (*SignedTx, error) {
+	return envelope.Tx.SignWith(envelope.NetworkCode, signer)
+}
+
+// ValidateSignedTx checks that the signed transaction returned from the offline machine is exactly
+// the transaction in the envelope, signed by its sender for the envelope's network.
+func (envelope *UnsignedTxEnvelope) ValidateSignedTx(signedTx *SignedTx) error {
+	if _, err := signedTx.Verify(envelope.NetworkCode); err != nil {
+		return err
+	}
+
+	txBytes, err := TryDecode64(signedTx.Tx)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTx, err)
+	}
+	expectedTxJson, err := tryToJson(envelope.Tx, false)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(txBytes, []byte(expectedTxJson)) {
+		return ErrEnvelopeMismatch
+	}
+
+	return nil
+}
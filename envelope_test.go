@@ -0,0 +1,100 @@
+package ownSdk
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEnvelope(t *testing.T, wallet *WalletInfo) *UnsignedTxEnvelope {
+	tx := CreateTx(wallet.Address, 3, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction("CHPvS1Hxs4oLcrbgKWYYmubSBjurjUdvjg8", MustParseAmount("12.5"))
+	tx.AddCreateAssetAction()
+	tx.AddCreateAccountAction()
+
+	envelope, err := NewUnsignedTxEnvelope("OWN_PUBLIC_BLOCKCHAIN_TESTNET", tx)
+	assert.NoError(t, err)
+	return envelope
+}
+
+func TestUnsignedTxEnvelopeSummaryAndDerivedHashes(t *testing.T) {
+	wallet := GenerateWallet()
+	envelope := newTestEnvelope(t, wallet)
+
+	assert.Equal(t, []DerivedHashDto{
+		{ActionNumber: 2, ActionType: "CreateAsset", Hash: DeriveHash(wallet.Address, 3, 2)},
+		{ActionNumber: 3, ActionType: "CreateAccount", Hash: DeriveHash(wallet.Address, 3, 3)},
+	}, envelope.DerivedHashes)
+
+	assert.Len(t, envelope.Summary, 4)
+	assert.Equal(t, "1. Transfer 12.5 CHX to CHPvS1Hxs4oLcrbgKWYYmubSBjurjUdvjg8", envelope.Summary[1])
+	assert.Equal(t, "2. Create asset "+DeriveHash(wallet.Address, 3, 2), envelope.Summary[2])
+}
+
+func TestUnsignedTxEnvelopeRoundtrip(t *testing.T) {
+	wallet := GenerateWallet()
+	envelope := newTestEnvelope(t, wallet)
+
+	decoded, err := DecodeUnsignedTxEnvelope(envelope.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, envelope, decoded)
+
+	parsed, err := ParseUnsignedTxEnvelope(envelope.ToJson(true))
+	assert.NoError(t, err)
+	assert.Equal(t, envelope, parsed)
+}
+
+func TestParseUnsignedTxEnvelopeRecomputesSummary(t *testing.T) {
+	wallet := GenerateWallet()
+	envelope := newTestEnvelope(t, wallet)
+	envelopeJson := envelope.ToJson(false)
+
+	// A summary produced differently, e.g. by another SDK version, doesn't make the envelope invalid.
+	reworded := strings.Replace(envelopeJson, "1. Transfer 12.5 CHX to", "1. Send 12.5 CHX to", 1)
+	parsed, err := ParseUnsignedTxEnvelope(reworded)
+	assert.NoError(t, err)
+	assert.Equal(t, envelope, parsed)
+
+	// The summary always describes the tx, not what the envelope claims.
+	tampered := strings.Replace(envelopeJson, `"amount":12.5`, `"amount":1250`, 1)
+	parsed, err = ParseUnsignedTxEnvelope(tampered)
+	assert.NoError(t, err)
+	assert.Equal(t, "1. Transfer 1250 CHX to CHPvS1Hxs4oLcrbgKWYYmubSBjurjUdvjg8", parsed.Summary[1])
+}
+
+func TestParseUnsignedTxEnvelopeRejectsTamperedDerivedHashes(t *testing.T) {
+	wallet := GenerateWallet()
+	envelope := newTestEnvelope(t, wallet)
+	tampered := strings.Replace(envelope.ToJson(false), envelope.DerivedHashes[0].Hash, DeriveHash(wallet.Address, 4, 2), 1)
+
+	_, err := ParseUnsignedTxEnvelope(tampered)
+	assert.ErrorIs(t, err, ErrInvalidEnvelope)
+
+	_, err = DecodeUnsignedTxEnvelope("not base64!")
+	assert.ErrorIs(t, err, ErrInvalidEnvelope)
+}
+
+func TestUnsignedTxEnvelopeSignAndValidate(t *testing.T) {
+	wallet := GenerateWallet()
+	envelope := newTestEnvelope(t, wallet)
+
+	// Offline side
+	offlineEnvelope, err := DecodeUnsignedTxEnvelope(envelope.Encode())
+	assert.NoError(t, err)
+	signedTx, err := offlineEnvelope.Sign(wallet.PrivateKey)
+	assert.NoError(t, err)
+
+	// Online side
+	assert.NoError(t, envelope.ValidateSignedTx(signedTx))
+
+	otherTx := CreateTx(wallet.Address, 3, MustParseAmount("0.01"), 0)
+	otherTx.AddTransferChxAction("CHPvS1Hxs4oLcrbgKWYYmubSBjurjUdvjg8", MustParseAmount("1250"))
+	otherSignedTx, err := otherTx.TrySign(envelope.NetworkCode, wallet.PrivateKey)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, envelope.ValidateSignedTx(otherSignedTx), ErrEnvelopeMismatch)
+
+	wrongNetworkSignedTx, err := envelope.Tx.TrySign("OWN_PUBLIC_BLOCKCHAIN_MAINNET", wallet.PrivateKey)
+	assert.NoError(t, err)
+	assert.Error(t, envelope.ValidateSignedTx(wrongNetworkSignedTx))
+}
@@ -0,0 +1,140 @@
+package ownSdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TxLifetimePolicy limits the expiration time of transactions it signs, and of transactions
+// submitted with Client.SubmitTx. Transactions without expiration time (0) are always allowed.
+type TxLifetimePolicy struct {
+	// MaxLifetime is how far in the future the expiration time may be. 0 disables the check.
+	MaxLifetime time.Duration
+}
+
+const DefaultTxMaxLifetime = 7 * 24 * time.Hour
+
+var ErrTxExpirationTooFar = errors.New("transaction expiration time is too far in the future")
+
+// DefaultTxLifetimePolicy returns the policy applied by Tx.TrySign, Tx.Sign, Tx.SignWith and
+// Client.SubmitTx, which allows expiration times up to DefaultTxMaxLifetime from now.
+func DefaultTxLifetimePolicy() TxLifetimePolicy {
+	return TxLifetimePolicy{MaxLifetime: DefaultTxMaxLifetime}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructors
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CreateTxWithExpiration creates a transaction which the node will not process after expiration.
+func CreateTxWithExpiration(senderAddress string, nonce int64, actionFee Amount, expiration time.Time) *Tx {
+	return CreateTx(senderAddress, nonce, actionFee, expiration.UnixMilli())
+}
+
+// CreateTxWithTTL creates a transaction which expires ttl from now.
+func CreateTxWithTTL(senderAddress string, nonce int64, actionFee Amount, ttl time.Duration) *Tx {
+	return CreateTxWithExpiration(senderAddress, nonce, actionFee, time.Now().Add(ttl))
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Expiration
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Expiration returns the expiration time, or false if the transaction does not expire.
+func (tx *Tx) Expiration() (time.Time, bool) {
+	if tx.ExpirationTime == 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(tx.ExpirationTime), true
+}
+
+func (tx *Tx) IsExpired(now time.Time) bool {
+	return tx.ExpirationTime != 0 && now.UnixMilli() > tx.ExpirationTime
+}
+
+func (p TxLifetimePolicy) checkExpirationTime(expirationTime int64, now time.Time) error {
+	if expirationTime == 0 {
+		return nil
+	}
+	expiration := time.UnixMilli(expirationTime)
+	if now.After(expiration) {
+		return fmt.Errorf("%w: expired at %s", ErrTxExpired, expiration.UTC().Format(time.RFC3339))
+	}
+	if p.MaxLifetime > 0 && expiration.Sub(now) > p.MaxLifetime {
+		return fmt.Errorf("%w: expires at %s, more than %s from now",
+			ErrTxExpirationTooFar, expiration.UTC().Format(time.RFC3339), p.MaxLifetime)
+	}
+	return nil
+}
+
+// Check returns ErrTxExpired if the transaction is expired at now, or ErrTxExpirationTooFar if
+// it expires more than MaxLifetime after now.
+func (p TxLifetimePolicy) Check(tx *Tx, now time.Time) error {
+	return p.checkExpirationTime(tx.ExpirationTime, now)
+}
+
+// checkSignedTx applies the policy to a signed transaction. Transactions which can't be decoded
+// are left to the node to reject.
+func (p TxLifetimePolicy) checkSignedTx(signedTx *SignedTx, now time.Time) error {
+	txBytes, err := TryDecode64(signedTx.Tx)
+	if err != nil {
+		return nil
+	}
+	var tx struct {
+		ExpirationTime int64 `json:"expirationTime"`
+	}
+	if err := json.Unmarshal(txBytes, &tx); err != nil {
+		return nil
+	}
+	return p.checkExpirationTime(tx.ExpirationTime, now)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Signing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func signTxJson(tx *Tx, signMessage func(json string) (string, error)) (*SignedTx, error) {
+	json, err := tryToJson(tx, false)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signMessage(json)
+	if err != nil {
+		return nil, err
+	}
+	signedTx := &SignedTx{
+		Tx:        Encode64([]byte(json)),
+		Signature: signature,
+	}
+
+	return signedTx, nil
+}
+
+// Sign signs the transaction like Tx.TrySign, applying this policy instead of the default one.
+func (p TxLifetimePolicy) Sign(tx *Tx, networkCode string, privateKey string) (*SignedTx, error) {
+	if err := p.Check(tx, time.Now()); err != nil {
+		return nil, err
+	}
+	return signTxJson(tx, func(json string) (string, error) {
+		return TrySignMessage(networkCode, privateKey, json)
+	})
+}
+
+// SignWith signs the transaction like Tx.SignWith, applying this policy instead of the default one.
+func (p TxLifetimePolicy) SignWith(tx *Tx, networkCode string, signer Signer) (*SignedTx, error) {
+	if signer.Address() != tx.SenderAddress {
+		return nil, fmt.Errorf("%w: signer address %s", ErrSignerMismatch, signer.Address())
+	}
+	if err := p.Check(tx, time.Now()); err != nil {
+		return nil, err
+	}
+	return signTxJson(tx, func(json string) (string, error) {
+		return SignMessageWith(networkCode, signer, json)
+	})
+}
@@ -0,0 +1,154 @@
+package ownSdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTxWithExpiration(t *testing.T) {
+	expiration := time.Date(2030, 1, 2, 3, 4, 5, 678000000, time.UTC)
+	tx := CreateTxWithExpiration("CHa", 1, MustParseAmount("0.01"), expiration)
+
+	assert.Equal(t, int64(1893553445678), tx.ExpirationTime)
+	txExpiration, ok := tx.Expiration()
+	assert.True(t, ok)
+	assert.True(t, expiration.Equal(txExpiration))
+
+	assert.False(t, tx.IsExpired(expiration))
+	assert.True(t, tx.IsExpired(expiration.Add(time.Millisecond)))
+}
+
+func TestCreateTxWithTTL(t *testing.T) {
+	before := time.Now()
+	tx := CreateTxWithTTL("CHa", 1, MustParseAmount("0.01"), 10*time.Minute)
+
+	assert.GreaterOrEqual(t, tx.ExpirationTime, before.Add(10*time.Minute).UnixMilli())
+	assert.LessOrEqual(t, tx.ExpirationTime, time.Now().Add(10*time.Minute).UnixMilli())
+	assert.False(t, tx.IsExpired(time.Now()))
+}
+
+func TestTxWithoutExpirationNeverExpires(t *testing.T) {
+	tx := CreateTx("CHa", 1, MustParseAmount("0.01"), 0)
+
+	_, ok := tx.Expiration()
+	assert.False(t, ok)
+	assert.False(t, tx.IsExpired(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, DefaultTxLifetimePolicy().Check(tx, time.Now()))
+}
+
+func TestTxLifetimePolicyCheck(t *testing.T) {
+	now := time.Now()
+	policy := TxLifetimePolicy{MaxLifetime: time.Hour}
+
+	inlineData := map[time.Duration]error{
+		-time.Second:     ErrTxExpired,
+		time.Minute:      nil,
+		time.Hour:        nil,
+		2 * time.Hour:    ErrTxExpirationTooFar,
+		1000 * time.Hour: ErrTxExpirationTooFar,
+	}
+
+	for ttl, expectedErr := range inlineData {
+		tx := CreateTxWithExpiration("CHa", 1, MustParseAmount("0.01"), now.Add(ttl))
+		err := policy.Check(tx, now)
+		if expectedErr == nil {
+			assert.NoError(t, err, ttl)
+		} else {
+			assert.ErrorIs(t, err, expectedErr, ttl)
+		}
+	}
+
+	tx := CreateTxWithExpiration("CHa", 1, MustParseAmount("0.01"), now.Add(1000*time.Hour))
+	assert.NoError(t, TxLifetimePolicy{}.Check(tx, now))
+}
+
+func TestSignRefusesExpiredTx(t *testing.T) {
+	wallet := GenerateWallet()
+	tx := CreateTxWithTTL(wallet.Address, 1, MustParseAmount("0.01"), -time.Minute)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("1"))
+
+	signedTx, err := tx.TrySign("OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey)
+	assert.Nil(t, signedTx)
+	assert.ErrorIs(t, err, ErrTxExpired)
+
+	signer, err := NewSignerFromWallet(wallet)
+	assert.NoError(t, err)
+	signedTx, err = tx.SignWith("OWN_PUBLIC_BLOCKCHAIN_TESTNET", signer)
+	assert.Nil(t, signedTx)
+	assert.ErrorIs(t, err, ErrTxExpired)
+
+	assert.NotNil(t, tx.Sign("OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey)) // Legacy Sign does not check
+
+	tx.ExpirationTime = time.Now().Add(30 * 24 * time.Hour).UnixMilli()
+	_, err = tx.TrySign("OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey)
+	assert.ErrorIs(t, err, ErrTxExpirationTooFar)
+	assert.NotNil(t, tx.Sign("OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey))
+}
+
+func TestSignWithCustomPolicy(t *testing.T) {
+	wallet := GenerateWallet()
+	tx := CreateTxWithTTL(wallet.Address, 1, MustParseAmount("0.01"), 30*24*time.Hour)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("1"))
+	policy := TxLifetimePolicy{MaxLifetime: 60 * 24 * time.Hour}
+
+	signedTx, err := policy.Sign(tx, "OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey)
+	assert.NoError(t, err)
+	verifiedTx, err := signedTx.Verify("OWN_PUBLIC_BLOCKCHAIN_TESTNET")
+	assert.NoError(t, err)
+	assert.Equal(t, tx, verifiedTx)
+
+	signer, err := NewSignerFromWallet(wallet)
+	assert.NoError(t, err)
+	signedWithSigner, err := policy.SignWith(tx, "OWN_PUBLIC_BLOCKCHAIN_TESTNET", signer)
+	assert.NoError(t, err)
+	assert.Equal(t, signedTx, signedWithSigner)
+
+	_, err = TxLifetimePolicy{MaxLifetime: time.Hour}.Sign(tx, "OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey)
+	assert.ErrorIs(t, err, ErrTxExpirationTooFar)
+}
+
+func TestClientSubmitTxRefusesExpiredTx(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	wallet := GenerateWallet()
+	tx := CreateTx(wallet.Address, 1, MustParseAmount("0.01"), time.Now().Add(-time.Minute).UnixMilli())
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("1"))
+	signedTx := tx.Sign("OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey) // Legacy Sign does not check
+
+	result, err := NewClient(server.URL).SubmitTx(context.Background(), signedTx)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrTxExpired)
+	assert.Equal(t, 0, requests)
+}
+
+func TestClientSubmitTxAppliesClientPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"txHash": "TxH1"}`))
+	}))
+	defer server.Close()
+
+	wallet := GenerateWallet()
+	tx := CreateTxWithTTL(wallet.Address, 1, MustParseAmount("0.01"), 30*24*time.Hour)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("1"))
+	policy := TxLifetimePolicy{MaxLifetime: 60 * 24 * time.Hour}
+	signedTx, err := policy.Sign(tx, "OWN_PUBLIC_BLOCKCHAIN_TESTNET", wallet.PrivateKey)
+	assert.NoError(t, err)
+
+	client := NewClient(server.URL)
+	_, err = client.SubmitTx(context.Background(), signedTx)
+	assert.ErrorIs(t, err, ErrTxExpirationTooFar)
+
+	client.TxLifetimePolicy = &policy
+	result, err := client.SubmitTx(context.Background(), signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, "TxH1", result.TxHash)
+}
@@ -0,0 +1,104 @@
+package ownSdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type FeeEstimate struct {
+	ActionFee Amount // Minimum action fee accepted by the node
+	TotalFee  Amount // ActionFee for every action of the transaction
+}
+
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Fees
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TotalFee returns the fee charged for the transaction, which is ActionFee for each action.
+func (tx *Tx) TotalFee() (Amount, error) {
+	return tx.ActionFee.Mul(int64(len(tx.Actions)))
+}
+
+// RequiredBalance returns the available CHX balance the sender needs for the transaction:
+// the total fee plus the CHX transferred and staked. Revoked stakes (negative DelegateStake
+// amounts) are not counted, since they are returned only after the unbonding period.
+func RequiredBalance(tx *Tx) (Amount, error) {
+	required, err := tx.TotalFee()
+	if err != nil {
+		return Amount{}, err
+	}
+
+	for _, action := range tx.Actions {
+		var amount Amount
+		switch dto := action.ActionData.(type) {
+		case TransferChxTxActionDto:
+			amount = dto.Amount
+		case *TransferChxTxActionDto:
+			amount = dto.Amount
+		case DelegateStakeTxActionDto:
+			amount = dto.Amount
+		case *DelegateStakeTxActionDto:
+			amount = dto.Amount
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if required, err = required.Add(amount); err != nil {
+			return Amount{}, err
+		}
+	}
+
+	return required, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Node Queries
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (c *Client) GetMinActionFee(ctx context.Context) (Amount, error) {
+	feeInfo, err := c.GetFeeInfo(ctx)
+	if err != nil {
+		return Amount{}, err
+	}
+	return feeInfo.MinTxActionFee, nil
+}
+
+// EstimateFee returns the lowest fees the node currently accepts for the transaction.
+func (c *Client) EstimateFee(ctx context.Context, tx *Tx) (*FeeEstimate, error) {
+	actionFee, err := c.GetMinActionFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	totalFee, err := actionFee.Mul(int64(len(tx.Actions)))
+	if err != nil {
+		return nil, err
+	}
+	return &FeeEstimate{ActionFee: actionFee, TotalFee: totalFee}, nil
+}
+
+// CheckBalance returns ErrInsufficientBalance if the sender's available balance is lower than
+// RequiredBalance(tx).
+func (c *Client) CheckBalance(ctx context.Context, tx *Tx) error {
+	required, err := RequiredBalance(tx)
+	if err != nil {
+		return err
+	}
+	addressInfo, err := c.GetAddressInfo(ctx, tx.SenderAddress)
+	if err != nil {
+		return err
+	}
+
+	available := addressInfo.Balance.Available
+	if available.Cmp(required) < 0 {
+		return fmt.Errorf("%w: %s CHX available, %s CHX required", ErrInsufficientBalance, available, required)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package ownSdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newFeeTestTx() *Tx {
+	tx := CreateTx("CHa", 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction("CHb", MustParseAmount("100"))
+	tx.AddTransferChxAction("CHc", MustParseAmount("0.5"))
+	tx.AddDelegateStakeAction("CHv", MustParseAmount("1000"))
+	tx.AddDelegateStakeAction("CHw", MustParseAmount("-200"))
+	tx.AddCreateAssetAction()
+	return tx
+}
+
+func TestTxTotalFee(t *testing.T) {
+	totalFee, err := newFeeTestTx().TotalFee()
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseAmount("0.05"), totalFee)
+
+	tx := CreateTx("CHa", 1, MaxAmount, 0)
+	tx.AddRemoveValidatorAction()
+	tx.AddRemoveValidatorAction()
+	_, err = tx.TotalFee()
+	assert.ErrorIs(t, err, ErrAmountOutOfRange)
+}
+
+func TestRequiredBalance(t *testing.T) {
+	required, err := RequiredBalance(newFeeTestTx())
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseAmount("1100.55"), required)
+}
+
+func TestClientEstimateFee(t *testing.T) {
+	client := newTestNode(t, map[string]string{
+		"GET /fee": `{"minTxActionFee": 0.001}`,
+	})
+
+	estimate, err := client.EstimateFee(context.Background(), newFeeTestTx())
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseAmount("0.001"), estimate.ActionFee)
+	assert.Equal(t, MustParseAmount("0.005"), estimate.TotalFee)
+}
+
+func TestClientCheckBalance(t *testing.T) {
+	client := newTestNode(t, map[string]string{
+		"GET /address/CHa": `{"blockchainAddress": "CHa", "nonce": 0, "balance": {"total": 1200, "staked": 100, "available": 1100.5}}`,
+	})
+	ctx := context.Background()
+
+	err := client.CheckBalance(ctx, newFeeTestTx())
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+	assert.EqualError(t, err, "insufficient balance: 1100.5 CHX available, 1100.55 CHX required")
+
+	tx := CreateTx("CHa", 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction("CHb", MustParseAmount("1100.49"))
+	assert.NoError(t, client.CheckBalance(ctx, tx))
+}
@@ -0,0 +1,288 @@
+package ownSdk
+
+import (
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	Sec1PrivateKeyPemType  = "EC PRIVATE KEY"
+	Pkcs8PrivateKeyPemType = "PRIVATE KEY"
+	PkixPublicKeyPemType   = "PUBLIC KEY"
+)
+
+var (
+	oidPublicKeyEcdsa = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// ecPrivateKey is the SEC1 (RFC 5915) private key structure.
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOid asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.ObjectIdentifier
+}
+
+// pkcs8PrivateKey is the PKCS #8 (RFC 5208) private key structure.
+type pkcs8PrivateKey struct {
+	Version    int
+	Algorithm  algorithmIdentifier
+	PrivateKey []byte
+}
+
+// pkixPublicKey is the X.509 SubjectPublicKeyInfo (RFC 5480) structure.
+type pkixPublicKey struct {
+	Algorithm algorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Hex
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func decodePrivateKey(privateKey string) ([]byte, error) {
+	privateKeyBytes, err := TryDecode58(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	if _, err := crypto.ToECDSA(privateKeyBytes); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	return privateKeyBytes, nil
+}
+
+func PrivateKeyToHex(privateKey string) (string, error) {
+	privateKeyBytes, err := decodePrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(privateKeyBytes), nil
+}
+
+// PrivateKeyFromHex returns the Base58 encoded private key. A "0x" prefix is allowed.
+func PrivateKeyFromHex(hexKey string) (string, error) {
+	privateKeyBytes, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	if _, err := crypto.ToECDSA(privateKeyBytes); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	return Encode58(privateKeyBytes), nil
+}
+
+// PublicKeyToHex returns the public key in compressed or uncompressed SEC1 form.
+func PublicKeyToHex(publicKey string, compressed bool) (string, error) {
+	key, err := TryParsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	if compressed {
+		return hex.EncodeToString(crypto.CompressPubkey(key)), nil
+	}
+	return hex.EncodeToString(crypto.FromECDSAPub(key)), nil
+}
+
+// PublicKeyFromHex accepts a compressed or uncompressed public key and returns it in the form of
+// WalletInfo.PublicKey.
+func PublicKeyFromHex(hexKey string) (string, error) {
+	publicKeyBytes, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	key, err := parsePublicKeyBytes(publicKeyBytes)
+	if err != nil {
+		return "", err
+	}
+	return Encode58(crypto.CompressPubkey(key)), nil
+}
+
+// CompressPublicKey converts a Base58 encoded public key to the compressed (33 byte) form.
+func CompressPublicKey(publicKey string) (string, error) {
+	key, err := TryParsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	return Encode58(crypto.CompressPubkey(key)), nil
+}
+
+// DecompressPublicKey converts a Base58 encoded public key to the uncompressed (65 byte) form.
+func DecompressPublicKey(publicKey string) (string, error) {
+	key, err := TryParsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	return Encode58(crypto.FromECDSAPub(key)), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// PEM
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func marshalSec1PrivateKey(privateKeyBytes []byte, includeCurve bool) ([]byte, error) {
+	key, err := crypto.ToECDSA(privateKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	publicKeyBytes := crypto.FromECDSAPub(&key.PublicKey)
+	ecKey := ecPrivateKey{
+		Version:    1,
+		PrivateKey: privateKeyBytes,
+		PublicKey:  asn1.BitString{Bytes: publicKeyBytes, BitLength: len(publicKeyBytes) * 8},
+	}
+	if includeCurve {
+		ecKey.NamedCurveOid = oidCurveSecp256k1
+	}
+	return asn1.Marshal(ecKey)
+}
+
+func parseSec1PrivateKey(der []byte) ([]byte, error) {
+	var ecKey ecPrivateKey
+	if rest, err := asn1.Unmarshal(der, &ecKey); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	} else if len(rest) > 0 {
+		return nil, fmt.Errorf("%w: trailing data after SEC1 key", ErrInvalidPrivateKey)
+	}
+	if ecKey.Version != 1 {
+		return nil, fmt.Errorf("%w: unsupported SEC1 key version %d", ErrInvalidPrivateKey, ecKey.Version)
+	}
+	if len(ecKey.NamedCurveOid) > 0 && !ecKey.NamedCurveOid.Equal(oidCurveSecp256k1) {
+		return nil, fmt.Errorf("%w: unsupported curve %v", ErrInvalidPrivateKey, ecKey.NamedCurveOid)
+	}
+	if len(ecKey.PrivateKey) > 32 {
+		return nil, fmt.Errorf("%w: expected 32 bytes, got %d", ErrInvalidPrivateKey, len(ecKey.PrivateKey))
+	}
+	privateKeyBytes := make([]byte, 32)
+	copy(privateKeyBytes[32-len(ecKey.PrivateKey):], ecKey.PrivateKey)
+	if _, err := crypto.ToECDSA(privateKeyBytes); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	return privateKeyBytes, nil
+}
+
+// PrivateKeyToSec1Pem encodes the private key as an "EC PRIVATE KEY" PEM block, as written by
+// `openssl ecparam -genkey -name secp256k1`.
+func PrivateKeyToSec1Pem(privateKey string) (string, error) {
+	privateKeyBytes, err := decodePrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	der, err := marshalSec1PrivateKey(privateKeyBytes, true)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: Sec1PrivateKeyPemType, Bytes: der})), nil
+}
+
+// PrivateKeyToPkcs8Pem encodes the private key as a PKCS #8 "PRIVATE KEY" PEM block.
+func PrivateKeyToPkcs8Pem(privateKey string) (string, error) {
+	privateKeyBytes, err := decodePrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	sec1Der, err := marshalSec1PrivateKey(privateKeyBytes, false)
+	if err != nil {
+		return "", err
+	}
+	der, err := asn1.Marshal(pkcs8PrivateKey{
+		Algorithm:  algorithmIdentifier{Algorithm: oidPublicKeyEcdsa, Parameters: oidCurveSecp256k1},
+		PrivateKey: sec1Der,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: Pkcs8PrivateKeyPemType, Bytes: der})), nil
+}
+
+// PrivateKeyFromPem decodes an unencrypted SEC1 or PKCS #8 secp256k1 private key and returns it
+// Base58 encoded.
+func PrivateKeyFromPem(pemKey string) (string, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return "", fmt.Errorf("%w: no PEM block found", ErrInvalidPrivateKey)
+	}
+
+	var privateKeyBytes []byte
+	var err error
+	switch block.Type {
+	case Sec1PrivateKeyPemType:
+		privateKeyBytes, err = parseSec1PrivateKey(block.Bytes)
+	case Pkcs8PrivateKeyPemType:
+		var pkcs8Key pkcs8PrivateKey
+		if _, err := asn1.Unmarshal(block.Bytes, &pkcs8Key); err != nil {
+			return "", fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+		}
+		if !pkcs8Key.Algorithm.Algorithm.Equal(oidPublicKeyEcdsa) ||
+			!pkcs8Key.Algorithm.Parameters.Equal(oidCurveSecp256k1) {
+			return "", fmt.Errorf("%w: not a secp256k1 key", ErrInvalidPrivateKey)
+		}
+		privateKeyBytes, err = parseSec1PrivateKey(pkcs8Key.PrivateKey)
+	default:
+		return "", fmt.Errorf("%w: unsupported PEM block type %q", ErrInvalidPrivateKey, block.Type)
+	}
+	if err != nil {
+		return "", err
+	}
+	return Encode58(privateKeyBytes), nil
+}
+
+// PublicKeyToPem encodes the public key as an X.509 "PUBLIC KEY" PEM block.
+func PublicKeyToPem(publicKey string) (string, error) {
+	key, err := TryParsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	publicKeyBytes := crypto.FromECDSAPub(key)
+	der, err := asn1.Marshal(pkixPublicKey{
+		Algorithm: algorithmIdentifier{Algorithm: oidPublicKeyEcdsa, Parameters: oidCurveSecp256k1},
+		PublicKey: asn1.BitString{Bytes: publicKeyBytes, BitLength: len(publicKeyBytes) * 8},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: PkixPublicKeyPemType, Bytes: der})), nil
+}
+
+// PublicKeyFromPem decodes an X.509 secp256k1 public key and returns it in the form of
+// WalletInfo.PublicKey.
+func PublicKeyFromPem(pemKey string) (string, error) {
+	block, _ := pem.Decode([]byte(pemKey))
+	if block == nil {
+		return "", fmt.Errorf("%w: no PEM block found", ErrInvalidPublicKey)
+	}
+	if block.Type != PkixPublicKeyPemType {
+		return "", fmt.Errorf("%w: unsupported PEM block type %q", ErrInvalidPublicKey, block.Type)
+	}
+
+	var pkixKey pkixPublicKey
+	if rest, err := asn1.Unmarshal(block.Bytes, &pkixKey); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	} else if len(rest) > 0 {
+		return "", fmt.Errorf("%w: trailing data after public key", ErrInvalidPublicKey)
+	}
+	if !pkixKey.Algorithm.Algorithm.Equal(oidPublicKeyEcdsa) ||
+		!pkixKey.Algorithm.Parameters.Equal(oidCurveSecp256k1) {
+		return "", fmt.Errorf("%w: not a secp256k1 key", ErrInvalidPublicKey)
+	}
+
+	key, err := parsePublicKeyBytes(pkixKey.PublicKey.RightAlign())
+	if err != nil {
+		return "", err
+	}
+	return Encode58(crypto.CompressPubkey(key)), nil
+}
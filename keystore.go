@@ -0,0 +1,249 @@
+package ownSdk
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	KeystoreVersion = 1
+	keystoreKdf     = "scrypt"
+	keystoreCipher  = "aes-256-gcm"
+	keystoreDkLen   = 64
+	keystoreSaltLen = 32
+	maxScryptN      = 1 << 20
+	maxScryptR      = 32
+	maxScryptP      = 16
+	maxScryptMemory = 1 << 30 // scrypt allocates 128 * N * r bytes
+)
+
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+type KeystoreKdfParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DkLen int    `json:"dkLen"`
+	Salt  string `json:"salt"`
+}
+
+type EncryptedKeystore struct {
+	Version    int               `json:"version"`
+	Kdf        string            `json:"kdf"`
+	KdfParams  KeystoreKdfParams `json:"kdfParams"`
+	Cipher     string            `json:"cipher"`
+	Nonce      string            `json:"nonce"`
+	Ciphertext string            `json:"ciphertext"`
+	Mac        string            `json:"mac"`
+}
+
+var (
+	DefaultScryptParams = ScryptParams{N: 1 << 17, R: 8, P: 1}
+	LightScryptParams   = ScryptParams{N: 1 << 12, R: 8, P: 1}
+)
+
+var (
+	ErrInvalidKeystore     = errors.New("invalid keystore")
+	ErrUnsupportedKeystore = errors.New("unsupported keystore")
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Key Derivation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (p KeystoreKdfParams) validate() error {
+	if p.N <= 1 || p.N > maxScryptN || p.N&(p.N-1) != 0 {
+		return fmt.Errorf("%w: scrypt N must be a power of 2 not greater than %d", ErrInvalidKeystore, maxScryptN)
+	}
+	if p.R <= 0 || p.R > maxScryptR || p.P <= 0 || p.P > maxScryptP {
+		return fmt.Errorf("%w: scrypt r must be at most %d and p at most %d", ErrInvalidKeystore, maxScryptR, maxScryptP)
+	}
+	if 128*uint64(p.N)*uint64(p.R) > maxScryptMemory {
+		return fmt.Errorf("%w: scrypt N and r require more than %d bytes of memory", ErrInvalidKeystore, maxScryptMemory)
+	}
+	if p.DkLen != keystoreDkLen {
+		return fmt.Errorf("%w: derived key length must be %d", ErrInvalidKeystore, keystoreDkLen)
+	}
+	return nil
+}
+
+func (p KeystoreKdfParams) deriveKey(password string) ([]byte, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	salt, err := TryDecode64(p.Salt)
+	if err != nil || len(salt) < 16 {
+		return nil, fmt.Errorf("%w: invalid salt", ErrInvalidKeystore)
+	}
+	return scrypt.Key([]byte(password), salt, p.N, p.R, p.P, p.DkLen)
+}
+
+// header binds the version and KDF parameters to the ciphertext, so they can't be swapped.
+func (k *EncryptedKeystore) header() []byte {
+	return []byte(fmt.Sprintf("%d|%s|%d|%d|%d|%d|%s|%s",
+		k.Version, k.Kdf, k.KdfParams.N, k.KdfParams.R, k.KdfParams.P, k.KdfParams.DkLen, k.KdfParams.Salt, k.Cipher))
+}
+
+func (k *EncryptedKeystore) mac(macKey []byte, nonce []byte, ciphertext []byte) []byte {
+	h := hmac.New(sha256.New, macKey)
+	h.Write(k.header())
+	h.Write(nonce)
+	h.Write(ciphertext)
+	return h.Sum(nil)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Keystore
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func createKeystoreFromSeed(seed []byte, password string, params ScryptParams) ([]byte, error) {
+	salt := make([]byte, keystoreSaltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+
+	keystore := &EncryptedKeystore{
+		Version: KeystoreVersion,
+		Kdf:     keystoreKdf,
+		KdfParams: KeystoreKdfParams{
+			N:     params.N,
+			R:     params.R,
+			P:     params.P,
+			DkLen: keystoreDkLen,
+			Salt:  Encode64(salt),
+		},
+		Cipher: keystoreCipher,
+	}
+
+	derivedKey, err := keystore.KdfParams.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+
+	cypher, err := aes.NewCipher(derivedKey[:32])
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(cypher)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	ciphertext := gcm.Seal(nil, nonce, seed, keystore.header())
+
+	keystore.Nonce = Encode64(nonce)
+	keystore.Ciphertext = Encode64(ciphertext)
+	keystore.Mac = Encode64(keystore.mac(derivedKey[32:], nonce, ciphertext))
+
+	return json.MarshalIndent(keystore, "", "    ")
+}
+
+func CreateKeystoreFromPasswordWithParams(mnemonic string, password string, params ScryptParams) ([]byte, error) {
+	seed, err := TryGenerateSeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+	return createKeystoreFromSeed(seed, password, params)
+}
+
+func CreateKeystoreFromPassword(mnemonic string, password string) ([]byte, error) {
+	return CreateKeystoreFromPasswordWithParams(mnemonic, password, DefaultScryptParams)
+}
+
+// OpenKeystore decrypts a keystore created by CreateKeystoreFromPassword and returns the seed.
+// ErrDecryptionFailed means a wrong password (or a keystore modified after creation),
+// while ErrInvalidKeystore and ErrUnsupportedKeystore mean the data is not a usable keystore.
+func OpenKeystore(data []byte, password string) ([]byte, error) {
+	keystore := &EncryptedKeystore{}
+	if err := json.Unmarshal(data, keystore); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKeystore, err)
+	}
+	if keystore.Version != KeystoreVersion {
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedKeystore, keystore.Version)
+	}
+	if keystore.Kdf != keystoreKdf || keystore.Cipher != keystoreCipher {
+		return nil, fmt.Errorf("%w: kdf %q, cipher %q", ErrUnsupportedKeystore, keystore.Kdf, keystore.Cipher)
+	}
+
+	nonce, err := TryDecode64(keystore.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid nonce", ErrInvalidKeystore)
+	}
+	ciphertext, err := TryDecode64(keystore.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ciphertext", ErrInvalidKeystore)
+	}
+	mac, err := TryDecode64(keystore.Mac)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid mac", ErrInvalidKeystore)
+	}
+
+	derivedKey, err := keystore.KdfParams.deriveKey(password)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, keystore.mac(derivedKey[32:], nonce, ciphertext)) {
+		return nil, ErrDecryptionFailed
+	}
+
+	cypher, err := aes.NewCipher(derivedKey[:32])
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(cypher)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid nonce", ErrInvalidKeystore)
+	}
+
+	seed, err := gcm.Open(nil, nonce, ciphertext, keystore.header())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKeystore, err)
+	}
+	return seed, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Migration
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// LegacyPasswordHash returns the SHA-256 password hash used with GenerateKeystore.
+func LegacyPasswordHash(password string) [32]byte {
+	return xsha256([]byte(password))
+}
+
+func ImportLegacyKeystoreWithParams(legacyKeystore []byte, passwordHash [32]byte, password string, params ScryptParams) ([]byte, error) {
+	seed, err := TryGenerateSeedFromKeystore(legacyKeystore, passwordHash)
+	if err != nil {
+		return nil, err
+	}
+	return createKeystoreFromSeed(seed, password, params)
+}
+
+// ImportLegacyKeystore converts a keystore created by GenerateKeystore into the current format.
+func ImportLegacyKeystore(legacyKeystore []byte, passwordHash [32]byte, password string) ([]byte, error) {
+	return ImportLegacyKeystoreWithParams(legacyKeystore, passwordHash, password, DefaultScryptParams)
+}
@@ -0,0 +1,122 @@
+package ownSdk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const keystoreTestMnemonic = "receive raccoon rocket donkey cherry garbage medal skirt random smoke young before scale leave hold insect foster blouse mail donkey regular vital hurt april"
+
+func TestCreateAndOpenKeystore(t *testing.T) {
+	keystore, err := CreateKeystoreFromPasswordWithParams(keystoreTestMnemonic, "pass", LightScryptParams)
+	assert.NoError(t, err)
+
+	seed, err := OpenKeystore(keystore, "pass")
+
+	assert.NoError(t, err)
+	assert.Equal(t, GenerateSeedFromMnemonic(keystoreTestMnemonic, ""), seed)
+	assert.Equal(t, "CHb5Z6Za34nv28Z3rLZ2Yd8LFikHaTqLhxB", GenerateWalletFromSeed(seed, 0).Address)
+}
+
+func TestCreateKeystoreWithDefaultParams(t *testing.T) {
+	if testing.Short() {
+		t.Skip("default scrypt parameters are slow")
+	}
+	keystore, err := CreateKeystoreFromPassword(keystoreTestMnemonic, "pass")
+	assert.NoError(t, err)
+
+	var parsed EncryptedKeystore
+	assert.NoError(t, json.Unmarshal(keystore, &parsed))
+	assert.Equal(t, KeystoreVersion, parsed.Version)
+	assert.Equal(t, "scrypt", parsed.Kdf)
+	assert.Equal(t, DefaultScryptParams.N, parsed.KdfParams.N)
+
+	seed, err := OpenKeystore(keystore, "pass")
+	assert.NoError(t, err)
+	assert.Equal(t, GenerateSeedFromMnemonic(keystoreTestMnemonic, ""), seed)
+}
+
+func TestCreateKeystoreUsesRandomSalt(t *testing.T) {
+	keystore1, _ := CreateKeystoreFromPasswordWithParams(keystoreTestMnemonic, "pass", LightScryptParams)
+	keystore2, _ := CreateKeystoreFromPasswordWithParams(keystoreTestMnemonic, "pass", LightScryptParams)
+
+	var parsed1, parsed2 EncryptedKeystore
+	json.Unmarshal(keystore1, &parsed1)
+	json.Unmarshal(keystore2, &parsed2)
+	assert.NotEqual(t, parsed1.KdfParams.Salt, parsed2.KdfParams.Salt)
+	assert.NotEqual(t, parsed1.Ciphertext, parsed2.Ciphertext)
+}
+
+func TestCreateKeystoreInvalidMnemonic(t *testing.T) {
+	keystore, err := CreateKeystoreFromPasswordWithParams("not a mnemonic", "pass", LightScryptParams)
+	assert.Nil(t, keystore)
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+}
+
+func TestOpenKeystoreWrongPassword(t *testing.T) {
+	keystore, _ := CreateKeystoreFromPasswordWithParams(keystoreTestMnemonic, "pass", LightScryptParams)
+
+	seed, err := OpenKeystore(keystore, "wrong")
+
+	assert.Nil(t, seed)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+}
+
+func TestOpenKeystoreCorrupted(t *testing.T) {
+	keystore, _ := CreateKeystoreFromPasswordWithParams(keystoreTestMnemonic, "pass", LightScryptParams)
+	var parsed EncryptedKeystore
+	json.Unmarshal(keystore, &parsed)
+
+	withParams := func(modify func(k *EncryptedKeystore)) []byte {
+		k := parsed
+		modify(&k)
+		b, _ := json.Marshal(k)
+		return b
+	}
+
+	inlineData := map[string]struct {
+		data        []byte
+		expectedErr error
+	}{
+		"not json":         {[]byte("{"), ErrInvalidKeystore},
+		"legacy blob":      {Encrypt([]byte("seed"), LegacyPasswordHash("pass")), ErrInvalidKeystore},
+		"future version":   {withParams(func(k *EncryptedKeystore) { k.Version = 2 }), ErrUnsupportedKeystore},
+		"unknown kdf":      {withParams(func(k *EncryptedKeystore) { k.Kdf = "pbkdf2" }), ErrUnsupportedKeystore},
+		"huge scrypt N":    {withParams(func(k *EncryptedKeystore) { k.KdfParams.N = 1 << 30 }), ErrInvalidKeystore},
+		"huge scrypt r":    {withParams(func(k *EncryptedKeystore) { k.KdfParams.N, k.KdfParams.R = 1<<20, 1024 }), ErrInvalidKeystore},
+		"huge scrypt p":    {withParams(func(k *EncryptedKeystore) { k.KdfParams.P = 1 << 20 }), ErrInvalidKeystore},
+		"huge memory":      {withParams(func(k *EncryptedKeystore) { k.KdfParams.N, k.KdfParams.R = 1<<20, 16 }), ErrInvalidKeystore},
+		"bad ciphertext":   {withParams(func(k *EncryptedKeystore) { k.Ciphertext = "!" }), ErrInvalidKeystore},
+		"swapped params":   {withParams(func(k *EncryptedKeystore) { k.KdfParams.N = 1 << 11 }), ErrDecryptionFailed},
+		"tampered payload": {withParams(func(k *EncryptedKeystore) { k.Ciphertext = Encode64([]byte("tampered")) }), ErrDecryptionFailed},
+	}
+
+	for name, data := range inlineData {
+		seed, err := OpenKeystore(data.data, "pass")
+		assert.Nil(t, seed, name)
+		assert.ErrorIs(t, err, data.expectedErr, name)
+	}
+}
+
+func TestImportLegacyKeystore(t *testing.T) {
+	passwordHash := LegacyPasswordHash("pass")
+	legacyKeystore := GenerateKeystore(keystoreTestMnemonic, passwordHash)
+
+	keystore, err := ImportLegacyKeystoreWithParams(legacyKeystore, passwordHash, "new pass", LightScryptParams)
+	assert.NoError(t, err)
+	seed, err := OpenKeystore(keystore, "new pass")
+
+	assert.NoError(t, err)
+	assert.Equal(t, GenerateSeedFromKeystore(legacyKeystore, passwordHash), seed)
+}
+
+func TestImportLegacyKeystoreWrongPassword(t *testing.T) {
+	legacyKeystore := GenerateKeystore(keystoreTestMnemonic, LegacyPasswordHash("pass"))
+
+	keystore, err := ImportLegacyKeystoreWithParams(legacyKeystore, LegacyPasswordHash("wrong"), "new pass", LightScryptParams)
+
+	assert.Nil(t, keystore)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+}
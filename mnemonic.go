@@ -0,0 +1,394 @@
+package ownSdk
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type MnemonicLanguage string
+
+const (
+	MnemonicEnglish            MnemonicLanguage = "english"
+	MnemonicJapanese           MnemonicLanguage = "japanese"
+	MnemonicKorean             MnemonicLanguage = "korean"
+	MnemonicSpanish            MnemonicLanguage = "spanish"
+	MnemonicChineseSimplified  MnemonicLanguage = "chinese_simplified"
+	MnemonicChineseTraditional MnemonicLanguage = "chinese_traditional"
+	MnemonicFrench             MnemonicLanguage = "french"
+	MnemonicItalian            MnemonicLanguage = "italian"
+	MnemonicCzech              MnemonicLanguage = "czech"
+)
+
+// MnemonicLanguages lists the supported languages in the order used for language detection.
+var MnemonicLanguages = []MnemonicLanguage{
+	MnemonicEnglish,
+	MnemonicJapanese,
+	MnemonicKorean,
+	MnemonicSpanish,
+	MnemonicChineseSimplified,
+	MnemonicChineseTraditional,
+	MnemonicFrench,
+	MnemonicItalian,
+	MnemonicCzech,
+}
+
+var MnemonicWordCounts = []int{12, 15, 18, 21, 24}
+
+const DefaultMnemonicWordCount = 24
+
+var (
+	ErrUnsupportedMnemonicLanguage = errors.New("unsupported mnemonic language")
+	ErrMnemonicWordCount           = errors.New("mnemonic must have 12, 15, 18, 21 or 24 words")
+	ErrMnemonicUnknownWord         = errors.New("unknown mnemonic word")
+	ErrMnemonicChecksum            = errors.New("mnemonic checksum mismatch")
+)
+
+// MnemonicError describes why a mnemonic is invalid. It matches both ErrInvalidMnemonic
+// and the specific reason (ErrMnemonicWordCount, ErrMnemonicUnknownWord or ErrMnemonicChecksum).
+type MnemonicError struct {
+	Reason      error
+	Language    MnemonicLanguage
+	WordIndex   int      // Zero-based index of the unknown word, or -1
+	Word        string   // The unknown word, if any
+	Suggestions []string // Closest known words for an unknown word
+}
+
+func (e *MnemonicError) Error() string {
+	if e.WordIndex >= 0 {
+		return fmt.Sprintf("%v: %v %q at position %d", ErrInvalidMnemonic, e.Reason, e.Word, e.WordIndex+1)
+	}
+	return fmt.Sprintf("%v: %v", ErrInvalidMnemonic, e.Reason)
+}
+
+func (e *MnemonicError) Is(target error) bool {
+	return target == ErrInvalidMnemonic
+}
+
+func (e *MnemonicError) Unwrap() error {
+	return e.Reason
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Wordlists
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type mnemonicWordlist struct {
+	language  MnemonicLanguage
+	separator string
+	words     []string       // NFKD normalized
+	indexes   map[string]int // NFKD normalized word -> index
+}
+
+var mnemonicWordlistSources = map[MnemonicLanguage][]string{
+	MnemonicEnglish:            wordlists.English,
+	MnemonicJapanese:           wordlists.Japanese,
+	MnemonicKorean:             wordlists.Korean,
+	MnemonicSpanish:            wordlists.Spanish,
+	MnemonicChineseSimplified:  wordlists.ChineseSimplified,
+	MnemonicChineseTraditional: wordlists.ChineseTraditional,
+	MnemonicFrench:             wordlists.French,
+	MnemonicItalian:            wordlists.Italian,
+	MnemonicCzech:              wordlists.Czech,
+}
+
+var (
+	mnemonicWordlistsOnce sync.Once
+	mnemonicWordlists     map[MnemonicLanguage]*mnemonicWordlist
+)
+
+func getMnemonicWordlist(language MnemonicLanguage) (*mnemonicWordlist, error) {
+	mnemonicWordlistsOnce.Do(func() {
+		mnemonicWordlists = make(map[MnemonicLanguage]*mnemonicWordlist)
+		for language, source := range mnemonicWordlistSources {
+			wordlist := &mnemonicWordlist{
+				language:  language,
+				separator: " ",
+				words:     make([]string, len(source)),
+				indexes:   make(map[string]int, len(source)),
+			}
+			if language == MnemonicJapanese {
+				wordlist.separator = "　" // Ideographic space, as specified by BIP39
+			}
+			for i, word := range source {
+				word = norm.NFKD.String(word)
+				wordlist.words[i] = word
+				wordlist.indexes[word] = i
+			}
+			mnemonicWordlists[language] = wordlist
+		}
+	})
+
+	wordlist, ok := mnemonicWordlists[language]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedMnemonicLanguage, language)
+	}
+	return wordlist, nil
+}
+
+func splitMnemonic(mnemonic string) []string {
+	return strings.Fields(norm.NFKD.String(mnemonic))
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Generation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func isValidMnemonicWordCount(wordCount int) bool {
+	for _, count := range MnemonicWordCounts {
+		if count == wordCount {
+			return true
+		}
+	}
+	return false
+}
+
+func TryGenerateMnemonicWithOptions(language MnemonicLanguage, wordCount int) (string, error) {
+	wordlist, err := getMnemonicWordlist(language)
+	if err != nil {
+		return "", err
+	}
+	if !isValidMnemonicWordCount(wordCount) {
+		return "", fmt.Errorf("%w: %d", ErrMnemonicWordCount, wordCount)
+	}
+
+	entropy := make([]byte, wordCount*4/3)
+	if _, err := io.ReadFull(rand.Reader, entropy); err != nil {
+		return "", err
+	}
+
+	return mnemonicFromEntropy(wordlist, entropy), nil
+}
+
+// mnemonicBits returns the entropy followed by its checksum, as a big integer.
+func mnemonicBits(entropy []byte) *big.Int {
+	checksumBits := uint(len(entropy) / 4)
+	checksum := sha256.Sum256(entropy)
+
+	bits := new(big.Int).SetBytes(entropy)
+	bits.Lsh(bits, checksumBits)
+	bits.Or(bits, big.NewInt(int64(checksum[0]>>(8-checksumBits))))
+	return bits
+}
+
+func mnemonicFromEntropy(wordlist *mnemonicWordlist, entropy []byte) string {
+	wordCount := len(entropy) * 3 / 4
+	bits := mnemonicBits(entropy)
+
+	words := make([]string, wordCount)
+	mask := big.NewInt(2047)
+	for i := wordCount - 1; i >= 0; i-- {
+		index := new(big.Int).And(bits, mask).Int64()
+		words[i] = wordlist.words[index]
+		bits.Rsh(bits, 11)
+	}
+
+	return norm.NFC.String(strings.Join(words, wordlist.separator))
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Validation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func validateMnemonicWords(wordlist *mnemonicWordlist, words []string) error {
+	if !isValidMnemonicWordCount(len(words)) {
+		return &MnemonicError{Reason: ErrMnemonicWordCount, Language: wordlist.language, WordIndex: -1}
+	}
+
+	bits := new(big.Int)
+	for i, word := range words {
+		index, ok := wordlist.indexes[word]
+		if !ok {
+			return &MnemonicError{
+				Reason:      ErrMnemonicUnknownWord,
+				Language:    wordlist.language,
+				WordIndex:   i,
+				Word:        norm.NFC.String(word),
+				Suggestions: closestMnemonicWords(wordlist, word, 3),
+			}
+		}
+		bits.Lsh(bits, 11)
+		bits.Or(bits, big.NewInt(int64(index)))
+	}
+
+	checksumBits := uint(len(words) / 3)
+	entropy := new(big.Int).Rsh(bits, checksumBits).FillBytes(make([]byte, len(words)*4/3))
+	if mnemonicBits(entropy).Cmp(bits) != 0 {
+		return &MnemonicError{Reason: ErrMnemonicChecksum, Language: wordlist.language, WordIndex: -1}
+	}
+
+	return nil
+}
+
+func ValidateMnemonicInLanguage(mnemonic string, language MnemonicLanguage) error {
+	wordlist, err := getMnemonicWordlist(language)
+	if err != nil {
+		return err
+	}
+	return validateMnemonicWords(wordlist, splitMnemonic(mnemonic))
+}
+
+// ValidateMnemonic detects the language of the mnemonic and validates it.
+// The returned error is a *MnemonicError unless the mnemonic is valid.
+func ValidateMnemonic(mnemonic string) error {
+	_, err := DetectMnemonicLanguage(mnemonic)
+	return err
+}
+
+// DetectMnemonicLanguage returns the language in which the mnemonic is valid. If it is not valid
+// in any language, the error describes the problem in the language matching the most words.
+func DetectMnemonicLanguage(mnemonic string) (MnemonicLanguage, error) {
+	words := splitMnemonic(mnemonic)
+
+	var bestErr error
+	bestKnownWords := -1
+	for _, language := range MnemonicLanguages {
+		wordlist, _ := getMnemonicWordlist(language)
+		err := validateMnemonicWords(wordlist, words)
+		if err == nil {
+			return language, nil
+		}
+
+		knownWords := 0
+		for _, word := range words {
+			if _, ok := wordlist.indexes[word]; ok {
+				knownWords++
+			}
+		}
+		if knownWords > bestKnownWords {
+			bestKnownWords = knownWords
+			bestErr = err
+		}
+	}
+
+	return "", bestErr
+}
+
+func IsMnemonicValid(mnemonic string) bool {
+	return ValidateMnemonic(mnemonic) == nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Seed
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// mnemonicSeed hashes the mnemonic as given, not the validated words, so whitespace which
+// validation ignores is part of the seed, the same as in other BIP39 implementations.
+func mnemonicSeed(mnemonic string, passphrase string) []byte {
+	password := []byte(norm.NFKD.String(mnemonic))
+	salt := []byte("mnemonic" + norm.NFKD.String(passphrase))
+	return pbkdf2.Key(password, salt, 2048, 64, sha512.New)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Suggestions
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MnemonicWordsWithPrefix returns up to limit words starting with prefix, for autocompletion.
+// A limit of 0 or less returns all matching words.
+func MnemonicWordsWithPrefix(language MnemonicLanguage, prefix string, limit int) ([]string, error) {
+	wordlist, err := getMnemonicWordlist(language)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix = norm.NFKD.String(strings.TrimSpace(prefix))
+	words := make([]string, 0)
+	if prefix == "" {
+		return words, nil
+	}
+	for _, word := range wordlist.words {
+		if strings.HasPrefix(word, prefix) {
+			words = append(words, norm.NFC.String(word))
+			if limit > 0 && len(words) == limit {
+				break
+			}
+		}
+	}
+	return words, nil
+}
+
+// SuggestMnemonicWords returns up to limit known words closest to a possibly misspelled word.
+func SuggestMnemonicWords(language MnemonicLanguage, word string, limit int) ([]string, error) {
+	wordlist, err := getMnemonicWordlist(language)
+	if err != nil {
+		return nil, err
+	}
+	return closestMnemonicWords(wordlist, norm.NFKD.String(strings.TrimSpace(word)), limit), nil
+}
+
+func closestMnemonicWords(wordlist *mnemonicWordlist, word string, limit int) []string {
+	type candidate struct {
+		word     string
+		distance int
+	}
+
+	maxDistance := 2
+	candidates := make([]candidate, 0)
+	for _, known := range wordlist.words {
+		distance := levenshteinDistance(word, known)
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{word: known, distance: distance})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	words := make([]string, 0, limit)
+	for _, c := range candidates {
+		if len(words) == limit {
+			break
+		}
+		words = append(words, norm.NFC.String(c.word))
+	}
+	return words
+}
+
+func levenshteinDistance(a string, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+func min3(a int, b int, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
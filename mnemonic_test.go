@@ -0,0 +1,158 @@
+package ownSdk
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Generation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestGenerateMnemonicWithOptions(t *testing.T) {
+	for _, language := range MnemonicLanguages {
+		for _, wordCount := range MnemonicWordCounts {
+			mnemonic, err := TryGenerateMnemonicWithOptions(language, wordCount)
+			assert.NoError(t, err)
+			assert.Len(t, strings.Fields(mnemonic), wordCount, "%s %d", language, wordCount)
+			assert.NoError(t, ValidateMnemonicInLanguage(mnemonic, language), "%s %d", language, wordCount)
+		}
+	}
+}
+
+func TestGenerateMnemonicWithOptionsInvalidOptions(t *testing.T) {
+	_, err := TryGenerateMnemonicWithOptions(MnemonicEnglish, 13)
+	assert.ErrorIs(t, err, ErrMnemonicWordCount)
+
+	_, err = TryGenerateMnemonicWithOptions("klingon", 12)
+	assert.ErrorIs(t, err, ErrUnsupportedMnemonicLanguage)
+}
+
+func TestGenerateMnemonicJapaneseSeparator(t *testing.T) {
+	mnemonic, err := TryGenerateMnemonicWithOptions(MnemonicJapanese, 12)
+	assert.NoError(t, err)
+	assert.Equal(t, 11, strings.Count(mnemonic, "　"))
+
+	language, err := DetectMnemonicLanguage(mnemonic)
+	assert.NoError(t, err)
+	assert.Equal(t, MnemonicJapanese, language)
+}
+
+func TestMnemonicFromEntropyTestVector(t *testing.T) {
+	wordlist, err := getMnemonicWordlist(MnemonicEnglish)
+	assert.NoError(t, err)
+
+	mnemonic := mnemonicFromEntropy(wordlist, make([]byte, 16))
+	assert.Equal(t, strings.Repeat("abandon ", 11)+"about", mnemonic)
+
+	seed, err := TryGenerateSeedFromMnemonic(mnemonic, "TREZOR")
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		hex.EncodeToString(seed))
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Validation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestValidateMnemonicUnknownWord(t *testing.T) {
+	mnemonic := "receive raccoon rocket donkey cherry garbage medal skirt random smoke young before scale leave hold insect foster blouse mail donkey regular vitl hurt april"
+
+	err := ValidateMnemonic(mnemonic)
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+	assert.ErrorIs(t, err, ErrMnemonicUnknownWord)
+
+	var mnemonicErr *MnemonicError
+	assert.True(t, errors.As(err, &mnemonicErr))
+	assert.Equal(t, MnemonicEnglish, mnemonicErr.Language)
+	assert.Equal(t, 21, mnemonicErr.WordIndex)
+	assert.Equal(t, "vitl", mnemonicErr.Word)
+	assert.Contains(t, mnemonicErr.Suggestions, "vital")
+}
+
+func TestValidateMnemonicChecksum(t *testing.T) {
+	mnemonic := "receive raccoon rocket donkey cherry garbage medal skirt random smoke young before scale leave hold insect foster blouse mail donkey regular vital hurt hurt"
+
+	err := ValidateMnemonic(mnemonic)
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+	assert.ErrorIs(t, err, ErrMnemonicChecksum)
+}
+
+func TestValidateMnemonicWordCount(t *testing.T) {
+	err := ValidateMnemonic("receive raccoon rocket")
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+	assert.ErrorIs(t, err, ErrMnemonicWordCount)
+}
+
+func TestValidateMnemonicIgnoresExtraWhitespace(t *testing.T) {
+	mnemonic := "  " + strings.Repeat("abandon  ", 11) + "about\n"
+	assert.True(t, IsMnemonicValid(mnemonic))
+}
+
+func TestSeedFromMnemonicKeepsWhitespace(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+
+	for _, m := range []string{
+		mnemonic + "\n",
+		"  " + mnemonic,
+		strings.Replace(mnemonic, " ", "  ", -1),
+		strings.Replace(mnemonic, " ", "\t", -1),
+	} {
+		seed, err := TryGenerateSeedFromMnemonic(m, "TREZOR")
+		assert.NoError(t, err)
+		assert.Equal(t, bip39.NewSeed(m, "TREZOR"), seed)
+		assert.NotEqual(t, GenerateSeedFromMnemonic(mnemonic, "TREZOR"), seed)
+	}
+}
+
+func TestWalletFromMnemonicWithTrailingNewline(t *testing.T) {
+	seed := GenerateSeedFromMnemonic(strings.Repeat("abandon ", 11)+"about\n", "")
+	assert.Equal(t, "CHLxya2ZCWUm3JSuDiqY8obQJGtxAotyvFm", GenerateWalletFromSeed(seed, 0).Address)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Suggestions
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestMnemonicWordsWithPrefix(t *testing.T) {
+	words, err := MnemonicWordsWithPrefix(MnemonicEnglish, "abs", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"absent", "absorb", "abstract", "absurd"}, words)
+
+	words, err = MnemonicWordsWithPrefix(MnemonicEnglish, "ab", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"abandon", "ability"}, words)
+
+	words, err = MnemonicWordsWithPrefix(MnemonicEnglish, "", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, words)
+}
+
+func TestSuggestMnemonicWords(t *testing.T) {
+	words, err := SuggestMnemonicWords(MnemonicEnglish, "raccon", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "raccoon", words[0])
+
+	words, err = SuggestMnemonicWords(MnemonicEnglish, "xxxxxxxx", 3)
+	assert.NoError(t, err)
+	assert.Empty(t, words)
+}
+
+func TestSeedFromJapaneseMnemonic(t *testing.T) {
+	// Test vector from https://github.com/bip32JP/bip32JP.github.io/blob/master/test_JP_BIP39.json
+	mnemonic := strings.Repeat("あいこくしん　", 11) + "あおぞら"
+	passphrase := "㍍ガバヴァぱばぐゞちぢ十人十色"
+	expectedSeed := "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55"
+
+	for _, m := range []string{mnemonic, strings.Replace(mnemonic, "　", " ", -1)} {
+		seed, err := TryGenerateSeedFromMnemonic(m, passphrase)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedSeed, hex.EncodeToString(seed))
+	}
+}
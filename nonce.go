@@ -0,0 +1,199 @@
+package ownSdk
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NonceManager hands out nonces for a single sender address. It is safe for concurrent use.
+//
+// Every nonce returned by Next is reserved until it is either tracked and confirmed, or released.
+// Released nonces, and gaps detected by Resync, are handed out again before new nonces,
+// because the node does not process a transaction until all lower nonces have been used.
+type NonceManager struct {
+	client  *Client
+	address string
+
+	mutex    sync.Mutex
+	seeded   bool
+	next     int64
+	free     []int64
+	reserved map[int64]string // Nonce -> TxHash ("" until tracked)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructor
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func NewNonceManager(client *Client, address string) *NonceManager {
+	manager := &NonceManager{
+		client:   client,
+		address:  address,
+		reserved: make(map[int64]string),
+	}
+
+	return manager
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Nonces
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (m *NonceManager) Next(ctx context.Context) (int64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.seeded {
+		addressInfo, err := m.client.GetAddressInfo(ctx, m.address)
+		if err != nil {
+			return 0, err
+		}
+		m.next = addressInfo.Nonce + 1
+		m.seeded = true
+	}
+
+	var nonce int64
+	if len(m.free) > 0 {
+		nonce = m.free[0]
+		m.free = m.free[1:]
+	} else {
+		nonce = m.next
+		m.next++
+	}
+
+	m.reserved[nonce] = ""
+	return nonce, nil
+}
+
+// Track records the hash of the transaction submitted with a reserved nonce.
+func (m *NonceManager) Track(nonce int64, txHash string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.reserved[nonce]; ok {
+		m.reserved[nonce] = txHash
+	}
+}
+
+// Confirm marks the transaction as processed by the node, which means its nonce is used up.
+func (m *NonceManager) Confirm(txHash string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for nonce, reservedTxHash := range m.reserved {
+		if reservedTxHash == txHash {
+			delete(m.reserved, nonce)
+		}
+	}
+}
+
+// Release returns a reserved nonce whose transaction was rejected or never submitted.
+func (m *NonceManager) Release(nonce int64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.reserved[nonce]; ok {
+		delete(m.reserved, nonce)
+		m.addFree(nonce)
+	}
+}
+
+// Drop releases the nonce of a tracked transaction which timed out or was dropped by the node.
+func (m *NonceManager) Drop(txHash string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for nonce, reservedTxHash := range m.reserved {
+		if reservedTxHash == txHash {
+			delete(m.reserved, nonce)
+			m.addFree(nonce)
+		}
+	}
+}
+
+func (m *NonceManager) addFree(nonce int64) {
+	i := sort.Search(len(m.free), func(i int) bool { return m.free[i] >= nonce })
+	if i < len(m.free) && m.free[i] == nonce {
+		return
+	}
+	m.free = append(m.free, 0)
+	copy(m.free[i+1:], m.free[i:])
+	m.free[i] = nonce
+}
+
+// Pending returns the tracked transactions which are not confirmed yet, keyed by tx hash.
+func (m *NonceManager) Pending() map[string]int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	pending := make(map[string]int64)
+	for nonce, txHash := range m.reserved {
+		if txHash != "" {
+			pending[txHash] = nonce
+		}
+	}
+	return pending
+}
+
+// Gaps returns the nonces which will be handed out again before any new nonce.
+func (m *NonceManager) Gaps() []int64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return append([]int64(nil), m.free...)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Synchronization
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Resync reconciles the manager with the node: nonces used on chain are forgotten, tracked
+// transactions unknown to the node are treated as dropped, and unreserved nonces between the
+// node's nonce and the next nonce are handed out again to fill the gaps.
+func (m *NonceManager) Resync(ctx context.Context) error {
+	addressInfo, err := m.client.GetAddressInfo(ctx, m.address)
+	if err != nil {
+		return err
+	}
+
+	dropped := make(map[string]bool)
+	for txHash := range m.Pending() {
+		_, err := m.client.GetTx(ctx, txHash)
+		if IsNotFoundError(err) {
+			dropped[txHash] = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	confirmedNonce := addressInfo.Nonce
+	for nonce, txHash := range m.reserved {
+		if nonce <= confirmedNonce {
+			delete(m.reserved, nonce)
+		} else if dropped[txHash] {
+			delete(m.reserved, nonce)
+		}
+	}
+
+	if !m.seeded || m.next <= confirmedNonce {
+		m.next = confirmedNonce + 1
+		m.seeded = true
+	}
+
+	m.free = m.free[:0]
+	for nonce := confirmedNonce + 1; nonce < m.next; nonce++ {
+		if _, ok := m.reserved[nonce]; !ok {
+			m.free = append(m.free, nonce)
+		}
+	}
+
+	return nil
+}
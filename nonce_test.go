@@ -0,0 +1,204 @@
+package ownSdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeNonceNode struct {
+	mutex        sync.Mutex
+	nonce        int64
+	knownTxs     map[string]bool
+	addressCalls int
+}
+
+func (node *fakeNonceNode) setNonce(nonce int64) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	node.nonce = nonce
+}
+
+func (node *fakeNonceNode) addKnownTx(txHash string) {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	node.knownTxs[txHash] = true
+}
+
+func (node *fakeNonceNode) addressCallCount() int {
+	node.mutex.Lock()
+	defer node.mutex.Unlock()
+	return node.addressCalls
+}
+
+func newFakeNonceNode(t *testing.T, nonce int64) (*fakeNonceNode, *Client) {
+	node := &fakeNonceNode{nonce: nonce, knownTxs: make(map[string]bool)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		node.mutex.Lock()
+		defer node.mutex.Unlock()
+
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/address/"):
+			node.addressCalls++
+			fmt.Fprintf(w, `{"blockchainAddress": "CHa", "nonce": %d, "balance": {}}`, node.nonce)
+		case strings.HasPrefix(r.URL.Path, "/tx/") && node.knownTxs[strings.TrimPrefix(r.URL.Path, "/tx/")]:
+			io.WriteString(w, `{"status": "Pending"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return node, NewClient(server.URL)
+}
+
+func TestNonceManagerSeedsFromNode(t *testing.T) {
+	node, client := newFakeNonceNode(t, 41)
+	manager := NewNonceManager(client, "CHa")
+	ctx := context.Background()
+
+	nonce1, err := manager.Next(ctx)
+	assert.NoError(t, err)
+	nonce2, err := manager.Next(ctx)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(42), nonce1)
+	assert.Equal(t, int64(43), nonce2)
+	assert.Equal(t, 1, node.addressCallCount())
+}
+
+func TestNonceManagerSeedError(t *testing.T) {
+	manager := NewNonceManager(newTestNode(t, map[string]string{}), "CHa")
+
+	_, err := manager.Next(context.Background())
+
+	assert.True(t, IsNotFoundError(err))
+}
+
+func TestNonceManagerIsConcurrencySafe(t *testing.T) {
+	_, client := newFakeNonceNode(t, 0)
+	manager := NewNonceManager(client, "CHa")
+	const count = 200
+
+	var wg sync.WaitGroup
+	nonces := make(chan int64, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := manager.Next(context.Background())
+			assert.NoError(t, err)
+			nonces <- nonce
+		}()
+	}
+	wg.Wait()
+	close(nonces)
+
+	seen := make(map[int64]bool)
+	for nonce := range nonces {
+		assert.False(t, seen[nonce], "duplicate nonce %d", nonce)
+		seen[nonce] = true
+	}
+	for nonce := int64(1); nonce <= count; nonce++ {
+		assert.True(t, seen[nonce], "missing nonce %d", nonce)
+	}
+}
+
+func TestNonceManagerReusesReleasedNonces(t *testing.T) {
+	_, client := newFakeNonceNode(t, 0)
+	manager := NewNonceManager(client, "CHa")
+	ctx := context.Background()
+
+	nonce1, _ := manager.Next(ctx)
+	nonce2, _ := manager.Next(ctx)
+	nonce3, _ := manager.Next(ctx)
+	manager.Release(nonce2)
+	manager.Release(nonce1)
+	manager.Release(nonce1)
+
+	assert.Equal(t, []int64{1, 2}, manager.Gaps())
+	next, _ := manager.Next(ctx)
+	assert.Equal(t, nonce1, next)
+	next, _ = manager.Next(ctx)
+	assert.Equal(t, nonce2, next)
+	next, _ = manager.Next(ctx)
+	assert.Equal(t, nonce3+1, next)
+}
+
+func TestNonceManagerTracksPendingTxs(t *testing.T) {
+	_, client := newFakeNonceNode(t, 0)
+	manager := NewNonceManager(client, "CHa")
+	ctx := context.Background()
+
+	nonce1, _ := manager.Next(ctx)
+	nonce2, _ := manager.Next(ctx)
+	manager.Track(nonce1, "TxH1")
+	manager.Track(nonce2, "TxH2")
+	assert.Equal(t, map[string]int64{"TxH1": 1, "TxH2": 2}, manager.Pending())
+
+	manager.Confirm("TxH1")
+	manager.Drop("TxH2")
+
+	assert.Equal(t, map[string]int64{}, manager.Pending())
+	assert.Equal(t, []int64{2}, manager.Gaps())
+}
+
+func TestNonceManagerResyncAfterNodeProgress(t *testing.T) {
+	node, client := newFakeNonceNode(t, 0)
+	manager := NewNonceManager(client, "CHa")
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		nonce, _ := manager.Next(ctx)
+		manager.Track(nonce, fmt.Sprintf("TxH%d", nonce))
+		node.addKnownTx(fmt.Sprintf("TxH%d", nonce))
+	}
+	node.setNonce(2)
+
+	assert.NoError(t, manager.Resync(ctx))
+
+	assert.Equal(t, map[string]int64{"TxH3": 3}, manager.Pending())
+	assert.Empty(t, manager.Gaps())
+	next, _ := manager.Next(ctx)
+	assert.Equal(t, int64(4), next)
+}
+
+func TestNonceManagerResyncRefillsDroppedTxs(t *testing.T) {
+	node, client := newFakeNonceNode(t, 10)
+	manager := NewNonceManager(client, "CHa")
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		nonce, _ := manager.Next(ctx)
+		manager.Track(nonce, fmt.Sprintf("TxH%d", nonce))
+	}
+	node.addKnownTx("TxH11")
+	node.addKnownTx("TxH13")
+
+	assert.NoError(t, manager.Resync(ctx))
+
+	assert.Equal(t, map[string]int64{"TxH11": 11, "TxH13": 13}, manager.Pending())
+	assert.Equal(t, []int64{12, 14}, manager.Gaps())
+	next, _ := manager.Next(ctx)
+	assert.Equal(t, int64(12), next)
+}
+
+func TestNonceManagerResyncWhenNodeIsAhead(t *testing.T) {
+	node, client := newFakeNonceNode(t, 0)
+	manager := NewNonceManager(client, "CHa")
+	ctx := context.Background()
+
+	manager.Next(ctx)
+	node.setNonce(20) // Another process sent transactions from the same address.
+
+	assert.NoError(t, manager.Resync(ctx))
+
+	next, _ := manager.Next(ctx)
+	assert.Equal(t, int64(21), next)
+}
@@ -0,0 +1,130 @@
+package ownSdk
+
+import (
+	"fmt"
+	"sync"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Signer produces signatures for a blockchain address without exposing the private key.
+// SignDigest must return a 65 byte R || S || V signature, as produced by SignMessage. High S
+// signatures are normalized to low S, and signatures which don't recover to Address are rejected
+// with ErrSignerMismatch.
+type Signer interface {
+	Address() string
+	SignDigest(digest [32]byte) ([]byte, error)
+}
+
+// PrivateKeySigner keeps the private key in memory as a byte slice, so it can be wiped with Zero.
+type PrivateKeySigner struct {
+	mutex      sync.RWMutex
+	privateKey []byte
+	address    string
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Constructors
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func NewPrivateKeySigner(privateKey []byte) (*PrivateKeySigner, error) {
+	address, err := addressFromPrivateKeyBytes(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &PrivateKeySigner{
+		privateKey: append([]byte(nil), privateKey...),
+		address:    address,
+	}
+	return signer, nil
+}
+
+func NewSignerFromWallet(wallet *WalletInfo) (*PrivateKeySigner, error) {
+	privateKey, err := TryDecode58(wallet.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrivateKey, err)
+	}
+	defer zeroBytes(privateKey)
+	return NewPrivateKeySigner(privateKey)
+}
+
+func NewSignerFromSeed(seed []byte, keyIndex uint32) (*PrivateKeySigner, error) {
+	return NewSignerFromSeedAtPath(seed, NewDerivationPath(0, ExternalChain, keyIndex))
+}
+
+func NewSignerFromSeedAtPath(seed []byte, path DerivationPath) (*PrivateKeySigner, error) {
+	privateKey, err := privateKeyFromSeedAtPath(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(privateKey)
+	return NewPrivateKeySigner(privateKey)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Signing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+func (s *PrivateKeySigner) Address() string {
+	return s.address
+}
+
+func (s *PrivateKeySigner) SignDigest(digest [32]byte) ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if s.privateKey == nil {
+		return nil, fmt.Errorf("%w: signer has been zeroed", ErrInvalidPrivateKey)
+	}
+	return signDigest(s.privateKey, digest)
+}
+
+// Zero wipes the private key from memory. The signer can't be used afterwards.
+func (s *PrivateKeySigner) Zero() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	zeroBytes(s.privateKey)
+	s.privateKey = nil
+}
+
+func signDigestWith(signer Signer, digest [32]byte) (string, error) {
+	signatureBytes, err := signer.SignDigest(digest)
+	if err != nil {
+		return "", err
+	}
+	signatureBytes = append([]byte(nil), signatureBytes...)
+	normalizeSignature(signatureBytes)
+	if err := validateSignature(signatureBytes); err != nil {
+		return "", err
+	}
+	signerAddress, err := recoverAddressFromSignatureBytes(digest, signatureBytes)
+	if err != nil {
+		return "", err
+	}
+	if signerAddress != signer.Address() {
+		return "", fmt.Errorf("%w: signature recovers to %s instead of %s", ErrSignerMismatch, signerAddress, signer.Address())
+	}
+	return Encode58(signatureBytes), nil
+}
+
+func SignMessageWith(networkCode string, signer Signer, message string) (string, error) {
+	return signDigestWith(signer, messageHash(networkCode, message))
+}
+
+func SignBytesWith(networkCode string, signer Signer, data []byte) (string, error) {
+	return signDigestWith(signer, bytesHash(networkCode, data))
+}
+
+func SignDigestWith(signer Signer, digest [32]byte) (string, error) {
+	return signDigestWith(signer, digest)
+}
@@ -0,0 +1,145 @@
+package ownSdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type remoteTestSigner struct {
+	address   string
+	signature []byte
+	err       error
+}
+
+func (s *remoteTestSigner) Address() string {
+	return s.address
+}
+
+func (s *remoteTestSigner) SignDigest(digest [32]byte) ([]byte, error) {
+	return s.signature, s.err
+}
+
+func TestSignerFromWalletMatchesSignMessage(t *testing.T) {
+	wallet := WalletFromPrivateKey("B6WNNx9oK8qRUU52PpzjXHZuv4NUb3Z33hdju3hhrceS")
+	signer, err := NewSignerFromWallet(wallet)
+	assert.NoError(t, err)
+
+	sig, err := SignMessageWith("UNIT_TESTS", signer, "Chainium")
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallet.Address, signer.Address())
+	assert.Equal(t, "6Hhxz2eP3AagR56mP4AAaKViUxHi3gM9c5weLDR48x4X4ynRBDfxsHGjhX9cni1mtCkNxbnZ783YPgMwVYV52X1w5", sig)
+}
+
+func TestSignerSignBytesAndDigest(t *testing.T) {
+	wallet := GenerateWallet()
+	signer, err := NewSignerFromWallet(wallet)
+	assert.NoError(t, err)
+	data := []byte{0, 1, 2, 3}
+
+	sig, err := SignBytesWith("UNIT_TESTS", signer, data)
+	assert.NoError(t, err)
+	expectedSig, _ := SignBytes("UNIT_TESTS", wallet.PrivateKey, data)
+	assert.Equal(t, expectedSig, sig)
+
+	digest := xsha256(data)
+	sig, err = SignDigestWith(signer, digest)
+	assert.NoError(t, err)
+	expectedSig, _ = SignDigest(wallet.PrivateKey, digest)
+	assert.Equal(t, expectedSig, sig)
+}
+
+func TestSignerFromSeed(t *testing.T) {
+	mnemonic := "receive raccoon rocket donkey cherry garbage medal skirt random smoke young before scale leave hold insect foster blouse mail donkey regular vital hurt april"
+	seed := GenerateSeedFromMnemonic(mnemonic, "")
+
+	signer, err := NewSignerFromSeed(seed, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "CHb5Z6Za34nv28Z3rLZ2Yd8LFikHaTqLhxB", signer.Address())
+}
+
+func TestSignerFromWalletInvalidPrivateKey(t *testing.T) {
+	signer, err := NewSignerFromWallet(&WalletInfo{PrivateKey: "0OIl"})
+	assert.Nil(t, signer)
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+}
+
+func TestSignerZero(t *testing.T) {
+	signer, _ := NewSignerFromWallet(GenerateWallet())
+
+	signer.Zero()
+	sig, err := SignMessageWith("UNIT_TESTS", signer, "Chainium")
+
+	assert.Equal(t, "", sig)
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+}
+
+func TestTxSignWithMatchesSign(t *testing.T) {
+	wallet := GenerateWallet()
+	signer, _ := NewSignerFromWallet(wallet)
+	tx := CreateTx(wallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("10"))
+
+	signedTx, err := tx.SignWith("UNIT_TESTS", signer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tx.Sign("UNIT_TESTS", wallet.PrivateKey), signedTx)
+	_, err = signedTx.Verify("UNIT_TESTS")
+	assert.NoError(t, err)
+}
+
+func TestTxSignWithRejectsOtherSender(t *testing.T) {
+	signer, _ := NewSignerFromWallet(GenerateWallet())
+	tx := CreateTx(GenerateWallet().Address, 1, MustParseAmount("0.01"), 0)
+
+	signedTx, err := tx.SignWith("UNIT_TESTS", signer)
+
+	assert.Nil(t, signedTx)
+	assert.ErrorIs(t, err, ErrSignerMismatch)
+}
+
+func TestTxSignWithExternalSigner(t *testing.T) {
+	wallet := GenerateWallet()
+	tx := CreateTx(wallet.Address, 1, MustParseAmount("0.01"), 0)
+
+	signerErr := errors.New("HSM unavailable")
+	signedTx, err := tx.SignWith("UNIT_TESTS", &remoteTestSigner{address: wallet.Address, err: signerErr})
+	assert.Nil(t, signedTx)
+	assert.ErrorIs(t, err, signerErr)
+
+	signedTx, err = tx.SignWith("UNIT_TESTS", &remoteTestSigner{address: wallet.Address, signature: make([]byte, 64)})
+	assert.Nil(t, signedTx)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestTxSignWithNormalizesHighSSignature(t *testing.T) {
+	wallet := GenerateWallet()
+	tx := CreateTx(wallet.Address, 1, MustParseAmount("0.01"), 0)
+	expected := tx.Sign("UNIT_TESTS", wallet.PrivateKey)
+
+	sigBytes := Decode58(expected.Signature)
+	signer := &remoteTestSigner{address: wallet.Address, signature: highSSignature(sigBytes)}
+	signedTx, err := tx.SignWith("UNIT_TESTS", signer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, signedTx)
+}
+
+func TestTxSignWithRejectsSignatureByOtherKey(t *testing.T) {
+	wallet := GenerateWallet()
+	otherWallet := GenerateWallet()
+	tx := CreateTx(wallet.Address, 1, MustParseAmount("0.01"), 0)
+	otherSignedTx := tx.Sign("UNIT_TESTS", otherWallet.PrivateKey)
+
+	signer := &remoteTestSigner{address: wallet.Address, signature: Decode58(otherSignedTx.Signature)}
+	signedTx, err := tx.SignWith("UNIT_TESTS", signer)
+	assert.Nil(t, signedTx)
+	assert.ErrorIs(t, err, ErrSignerMismatch)
+
+	sig, err := SignMessageWith("UNIT_TESTS", signer, "Chainium")
+	assert.Equal(t, "", sig)
+	assert.ErrorIs(t, err, ErrSignerMismatch)
+}
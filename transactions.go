@@ -2,6 +2,7 @@ package ownSdk
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -10,15 +11,15 @@ import (
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type TxAction struct {
-	ActionType string      `json:"actionType"`
-	ActionData interface{} `json:"actionData"`
+	ActionType ActionType `json:"actionType"`
+	ActionData ActionData `json:"actionData"`
 }
 
 type Tx struct {
 	SenderAddress  string     `json:"senderAddress"`
 	Nonce          int64      `json:"nonce"`
 	ExpirationTime int64      `json:"expirationTime"`
-	ActionFee      float64    `json:"actionFee"`
+	ActionFee      Amount     `json:"actionFee"`
 	Actions        []TxAction `json:"actions"`
 }
 
@@ -28,13 +29,13 @@ type SignedTx struct {
 }
 
 type TransferChxTxActionDto struct {
-	RecipientAddress string  `json:"recipientAddress"`
-	Amount           float64 `json:"amount"`
+	RecipientAddress string `json:"recipientAddress"`
+	Amount           Amount `json:"amount"`
 }
 
 type DelegateStakeTxActionDto struct {
-	ValidatorAddress string  `json:"validatorAddress"`
-	Amount           float64 `json:"amount"`
+	ValidatorAddress string `json:"validatorAddress"`
+	Amount           Amount `json:"amount"`
 }
 
 type ConfigureValidatorTxActionDto struct {
@@ -46,16 +47,16 @@ type ConfigureValidatorTxActionDto struct {
 type RemoveValidatorTxActionDto struct{}
 
 type TransferAssetTxActionDto struct {
-	FromAccountHash string  `json:"fromAccountHash"`
-	ToAccountHash   string  `json:"toAccountHash"`
-	AssetHash       string  `json:"assetHash"`
-	Amount          float64 `json:"amount"`
+	FromAccountHash string `json:"fromAccountHash"`
+	ToAccountHash   string `json:"toAccountHash"`
+	AssetHash       string `json:"assetHash"`
+	Amount          Amount `json:"amount"`
 }
 
 type CreateAssetEmissionTxActionDto struct {
-	EmissionAccountHash string  `json:"emissionAccountHash"`
-	AssetHash           string  `json:"assetHash"`
-	Amount              float64 `json:"amount"`
+	EmissionAccountHash string `json:"emissionAccountHash"`
+	AssetHash           string `json:"assetHash"`
+	Amount              Amount `json:"amount"`
 }
 
 type CreateAssetTxActionDto struct{}
@@ -85,10 +86,10 @@ type SubmitVoteTxActionDto struct {
 }
 
 type SubmitVoteWeightTxActionDto struct {
-	AccountHash    string  `json:"accountHash"`
-	AssetHash      string  `json:"assetHash"`
-	ResolutionHash string  `json:"resolutionHash"`
-	VoteWeight     float64 `json:"voteWeight"`
+	AccountHash    string `json:"accountHash"`
+	AssetHash      string `json:"assetHash"`
+	ResolutionHash string `json:"resolutionHash"`
+	VoteWeight     Amount `json:"voteWeight"`
 }
 
 type SetAccountEligibilityTxActionDto struct {
@@ -119,11 +120,21 @@ type RemoveKycProviderTxActionDto struct {
 	ProviderAddress string `json:"providerAddress"`
 }
 
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Errors
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	ErrInvalidTx         = errors.New("invalid transaction")
+	ErrUnknownActionType = errors.New("unknown action type")
+	ErrSignerMismatch    = errors.New("transaction is not signed by its sender")
+)
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 // Constructor
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func CreateTx(senderAddress string, nonce int64, actionFee float64, expirationTime int64) *Tx {
+func CreateTx(senderAddress string, nonce int64, actionFee Amount, expirationTime int64) *Tx {
 	tx := &Tx{
 		SenderAddress:  senderAddress,
 		Nonce:          nonce,
@@ -139,25 +150,33 @@ func CreateTx(senderAddress string, nonce int64, actionFee float64, expirationTi
 // Actions
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func (tx *Tx) addAction(actionType string, actionData interface{}) {
-	txAction := TxAction{ActionType: actionType, ActionData: actionData}
+// AddAction appends an action of any type, including ones registered with RegisterActionType.
+func (tx *Tx) AddAction(actionData ActionData) {
+	txAction := TxAction{ActionType: actionData.ActionType(), ActionData: actionData}
 	tx.Actions = append(tx.Actions, txAction)
 }
 
-func (tx *Tx) AddTransferChxAction(recipientAddress string, amount float64) {
+func (tx *Tx) AddTransferChxAction(recipientAddress string, amount Amount) {
 	dto := TransferChxTxActionDto{
 		RecipientAddress: recipientAddress,
 		Amount:           amount,
 	}
-	tx.addAction("TransferChx", dto)
+	tx.AddAction(dto)
 }
 
-func (tx *Tx) AddDelegateStakeAction(validatorAddress string, amount float64) {
+func (tx *Tx) AddDelegateStakeAction(validatorAddress string, amount Amount) {
 	dto := DelegateStakeTxActionDto{
 		ValidatorAddress: validatorAddress,
 		Amount:           amount,
 	}
-	tx.addAction("DelegateStake", dto)
+	tx.AddAction(dto)
+}
+
+// AddRevokeStakeAction revokes amount of the stake delegated to the validator. The sign of amount
+// is ignored. The node has no separate action for it: revoking is a DelegateStake action with a
+// negative amount, and the CHX is returned to the sender after the unbonding period.
+func (tx *Tx) AddRevokeStakeAction(validatorAddress string, amount Amount) {
+	tx.AddDelegateStakeAction(validatorAddress, amount.Abs().Neg())
 }
 
 func (tx *Tx) AddConfigureValidatorAction(networkAddress string, sharedRewardPercent float64, isEnabled bool) {
@@ -166,36 +185,36 @@ func (tx *Tx) AddConfigureValidatorAction(networkAddress string, sharedRewardPer
 		SharedRewardPercent: sharedRewardPercent,
 		IsEnabled:           isEnabled,
 	}
-	tx.addAction("ConfigureValidator", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddRemoveValidatorAction() {
 	dto := RemoveValidatorTxActionDto{}
-	tx.addAction("RemoveValidator", dto)
+	tx.AddAction(dto)
 }
 
-func (tx *Tx) AddTransferAssetAction(fromAccountHash string, toAccountHash string, assetHash string, amount float64) {
+func (tx *Tx) AddTransferAssetAction(fromAccountHash string, toAccountHash string, assetHash string, amount Amount) {
 	dto := TransferAssetTxActionDto{
 		FromAccountHash: fromAccountHash,
 		ToAccountHash:   toAccountHash,
 		AssetHash:       assetHash,
 		Amount:          amount,
 	}
-	tx.addAction("TransferAsset", dto)
+	tx.AddAction(dto)
 }
 
-func (tx *Tx) AddCreateAssetEmissionAction(emissionAccountHash string, assetHash string, amount float64) {
+func (tx *Tx) AddCreateAssetEmissionAction(emissionAccountHash string, assetHash string, amount Amount) {
 	dto := CreateAssetEmissionTxActionDto{
 		EmissionAccountHash: emissionAccountHash,
 		AssetHash:           assetHash,
 		Amount:              amount,
 	}
-	tx.addAction("CreateAssetEmission", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddCreateAssetAction() string {
 	dto := CreateAssetTxActionDto{}
-	tx.addAction("CreateAsset", dto)
+	tx.AddAction(dto)
 	return DeriveHash(tx.SenderAddress, tx.Nonce, int16(len(tx.Actions)))
 }
 
@@ -204,7 +223,7 @@ func (tx *Tx) AddSetAssetCodeAction(assetHash string, assetCode string) {
 		AssetHash: assetHash,
 		AssetCode: assetCode,
 	}
-	tx.addAction("SetAssetCode", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddSetAssetControllerAction(assetHash string, controllerAddress string) {
@@ -212,12 +231,12 @@ func (tx *Tx) AddSetAssetControllerAction(assetHash string, controllerAddress st
 		AssetHash:         assetHash,
 		ControllerAddress: controllerAddress,
 	}
-	tx.addAction("SetAssetController", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddCreateAccountAction() string {
 	dto := CreateAccountTxActionDto{}
-	tx.addAction("CreateAccount", dto)
+	tx.AddAction(dto)
 	return DeriveHash(tx.SenderAddress, tx.Nonce, int16(len(tx.Actions)))
 }
 
@@ -226,7 +245,7 @@ func (tx *Tx) AddSetAccountControllerAction(accountHash string, controllerAddres
 		AccountHash:       accountHash,
 		ControllerAddress: controllerAddress,
 	}
-	tx.addAction("SetAccountController", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddSubmitVoteAction(accountHash string, assetHash string, resolutionHash string, voteHash string) {
@@ -236,17 +255,17 @@ func (tx *Tx) AddSubmitVoteAction(accountHash string, assetHash string, resoluti
 		ResolutionHash: resolutionHash,
 		VoteHash:       voteHash,
 	}
-	tx.addAction("SubmitVote", dto)
+	tx.AddAction(dto)
 }
 
-func (tx *Tx) AddSubmitVoteWeightAction(accountHash string, assetHash string, resolutionHash string, voteWeight float64) {
+func (tx *Tx) AddSubmitVoteWeightAction(accountHash string, assetHash string, resolutionHash string, voteWeight Amount) {
 	dto := SubmitVoteWeightTxActionDto{
 		AccountHash:    accountHash,
 		AssetHash:      assetHash,
 		ResolutionHash: resolutionHash,
 		VoteWeight:     voteWeight,
 	}
-	tx.addAction("SubmitVoteWeight", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddSetAccountEligibilityAction(accountHash string, assetHash string, isPrimaryEligible bool, isSecondaryEligible bool) {
@@ -256,7 +275,7 @@ func (tx *Tx) AddSetAccountEligibilityAction(accountHash string, assetHash strin
 		IsPrimaryEligible:   isPrimaryEligible,
 		IsSecondaryEligible: isSecondaryEligible,
 	}
-	tx.addAction("SetAccountEligibility", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddSetAssetEligibilityAction(assetHash string, isEligibilityRequired bool) {
@@ -264,7 +283,7 @@ func (tx *Tx) AddSetAssetEligibilityAction(assetHash string, isEligibilityRequir
 		AssetHash:             assetHash,
 		IsEligibilityRequired: isEligibilityRequired,
 	}
-	tx.addAction("SetAssetEligibility", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddChangeKycControllerAddressAction(accountHash string, assetHash string, kycControllerAddress string) {
@@ -273,7 +292,7 @@ func (tx *Tx) AddChangeKycControllerAddressAction(accountHash string, assetHash
 		AssetHash:            assetHash,
 		KycControllerAddress: kycControllerAddress,
 	}
-	tx.addAction("ChangeKycControllerAddress", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddAddKycProviderAction(assetHash string, providerAddress string) {
@@ -281,7 +300,7 @@ func (tx *Tx) AddAddKycProviderAction(assetHash string, providerAddress string)
 		AssetHash:       assetHash,
 		ProviderAddress: providerAddress,
 	}
-	tx.addAction("AddKycProvider", dto)
+	tx.AddAction(dto)
 }
 
 func (tx *Tx) AddRemoveKycProviderAction(assetHash string, providerAddress string) {
@@ -289,14 +308,14 @@ func (tx *Tx) AddRemoveKycProviderAction(assetHash string, providerAddress strin
 		AssetHash:       assetHash,
 		ProviderAddress: providerAddress,
 	}
-	tx.addAction("RemoveKycProvider", dto)
+	tx.AddAction(dto)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 // Signing
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
-func toJson(data interface{}, indentation bool) string {
+func tryToJson(data interface{}, indentation bool) (string, error) {
 	var b []byte
 	var err error
 	if indentation {
@@ -306,12 +325,17 @@ func toJson(data interface{}, indentation bool) string {
 	}
 
 	if err != nil {
-		fmt.Println(err)
-		return ""
+		return "", err
 	}
 
-	return string(b)
+	return string(b), nil
+}
+
+func toJson(data interface{}, indentation bool) string {
+	json, _ := tryToJson(data, indentation)
+	return json
 }
+
 func (tx *Tx) ToJson(indentation bool) string {
 	return toJson(tx, indentation)
 }
@@ -320,6 +344,33 @@ func (signedTx *SignedTx) ToJson(indentation bool) string {
 	return toJson(signedTx, indentation)
 }
 
+// TxHash returns the hash under which the node tracks the transaction once it is signed with Sign.
+func (tx *Tx) TxHash() string {
+	return Hash([]byte(tx.ToJson(false)))
+}
+
+func (signedTx *SignedTx) TryTxHash() (string, error) {
+	txBytes, err := TryDecode64(signedTx.Tx)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTx, err)
+	}
+	return Hash(txBytes), nil
+}
+
+func (signedTx *SignedTx) TxHash() string {
+	txHash, _ := signedTx.TryTxHash()
+	return txHash
+}
+
+func (tx *Tx) TrySign(networkCode string, privateKey string) (*SignedTx, error) {
+	return DefaultTxLifetimePolicy().Sign(tx, networkCode, privateKey)
+}
+
+func (tx *Tx) SignWith(networkCode string, signer Signer) (*SignedTx, error) {
+	return DefaultTxLifetimePolicy().SignWith(tx, networkCode, signer)
+}
+
+// Sign does not apply the lifetime policy, for compatibility. Use TrySign to have it checked.
 func (tx *Tx) Sign(networkCode string, privateKey string) *SignedTx {
 	json := tx.ToJson(false)
 	signature := SignMessage(networkCode, privateKey, json)
@@ -330,3 +381,64 @@ func (tx *Tx) Sign(networkCode string, privateKey string) *SignedTx {
 
 	return signedTx
 }
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Parsing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ParseTx parses a transaction, returning ErrUnknownActionType if it contains an action type
+// which is not registered.
+func ParseTx(txJson string) (*Tx, error) {
+	tx := &Tx{}
+	if err := json.Unmarshal([]byte(txJson), tx); err != nil {
+		if errors.Is(err, ErrInvalidTx) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTx, err)
+	}
+	if tx.Actions == nil {
+		tx.Actions = make([]TxAction, 0)
+	}
+
+	for _, action := range tx.Actions {
+		if _, ok := action.ActionData.(RawActionData); ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownActionType, action.ActionType)
+		}
+	}
+
+	return tx, nil
+}
+
+func ParseSignedTx(signedTxJson string) (*SignedTx, error) {
+	signedTx := &SignedTx{}
+	if err := json.Unmarshal([]byte(signedTxJson), signedTx); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTx, err)
+	}
+	if signedTx.Tx == "" || signedTx.Signature == "" {
+		return nil, fmt.Errorf("%w: tx and signature are required", ErrInvalidTx)
+	}
+
+	return signedTx, nil
+}
+
+func (signedTx *SignedTx) Verify(networkCode string) (*Tx, error) {
+	txBytes, err := TryDecode64(signedTx.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTx, err)
+	}
+
+	tx, err := ParseTx(string(txBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	signerAddress, err := VerifyMessageSignature(networkCode, signedTx.Signature, string(txBytes))
+	if err != nil {
+		return nil, err
+	}
+	if signerAddress != tx.SenderAddress {
+		return nil, fmt.Errorf("%w: signed by %s", ErrSignerMismatch, signerAddress)
+	}
+
+	return tx, nil
+}
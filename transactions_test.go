@@ -23,7 +23,7 @@ func TestCreateTxCorrectJson(t *testing.T) {
     "actions": []
 }`, senderWallet.Address)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 123)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 123)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
 }
@@ -40,7 +40,7 @@ func TestCreateTxExpirationTimeZeroIfNotProvided(t *testing.T) {
     "actions": []
 }`, senderWallet.Address)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
 }
@@ -52,7 +52,7 @@ func TestCreateTxExpirationTimeZeroIfNotProvided(t *testing.T) {
 func TestAddTransferChxAction(t *testing.T) {
 	senderWallet := GenerateWallet()
 	recipientWallet := GenerateWallet()
-	var amount float64 = 1000
+	amount := MustParseAmount("1000")
 
 	expectedJson :=
 		fmt.Sprintf(
@@ -66,13 +66,13 @@ func TestAddTransferChxAction(t *testing.T) {
             "actionType": "TransferChx",
             "actionData": {
                 "recipientAddress": "%s",
-                "amount": %3.0f
+                "amount": %s
             }
         }
     ]
 }`, senderWallet.Address, recipientWallet.Address, amount)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddTransferChxAction(recipientWallet.Address, amount)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -81,7 +81,7 @@ func TestAddTransferChxAction(t *testing.T) {
 func TestAddDelegateStakeAction(t *testing.T) {
 	senderWallet := GenerateWallet()
 	validatorWallet := GenerateWallet()
-	var amount float64 = 100000
+	amount := MustParseAmount("100000")
 
 	expectedJson :=
 		fmt.Sprintf(
@@ -95,18 +95,56 @@ func TestAddDelegateStakeAction(t *testing.T) {
             "actionType": "DelegateStake",
             "actionData": {
                 "validatorAddress": "%s",
-                "amount": %3.0f
+                "amount": %s
             }
         }
     ]
 }`, senderWallet.Address, validatorWallet.Address, amount)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddDelegateStakeAction(validatorWallet.Address, amount)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
 }
 
+func TestAddRevokeStakeAction(t *testing.T) {
+	senderWallet := GenerateWallet()
+	validatorWallet := GenerateWallet()
+
+	expectedJson :=
+		fmt.Sprintf(
+			`{
+    "senderAddress": "%s",
+    "nonce": 1,
+    "expirationTime": 0,
+    "actionFee": 0.01,
+    "actions": [
+        {
+            "actionType": "DelegateStake",
+            "actionData": {
+                "validatorAddress": "%s",
+                "amount": -2500.5
+            }
+        }
+    ]
+}`, senderWallet.Address, validatorWallet.Address)
+
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddRevokeStakeAction(validatorWallet.Address, MustParseAmount("2500.5"))
+	actualJson := tx.ToJson(true)
+	assert.Equal(t, expectedJson, actualJson)
+}
+
+func TestAddRevokeStakeActionWithNegativeAmount(t *testing.T) {
+	senderWallet := GenerateWallet()
+	validatorWallet := GenerateWallet()
+
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddRevokeStakeAction(validatorWallet.Address, MustParseAmount("-2500.5"))
+
+	assert.Equal(t, MustParseAmount("-2500.5"), tx.Actions[0].ActionData.(DelegateStakeTxActionDto).Amount)
+}
+
 func TestAddConfigureValidatorAction(t *testing.T) {
 	senderWallet := GenerateWallet()
 	networkAddress := "val01.some.domain.com:25718"
@@ -132,7 +170,7 @@ func TestAddConfigureValidatorAction(t *testing.T) {
     ]
 }`, senderWallet.Address, networkAddress, sharedRewardPercent, isEnabled)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddConfigureValidatorAction(networkAddress, sharedRewardPercent, isEnabled)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -156,7 +194,7 @@ func TestAddRemoveValidatorAction(t *testing.T) {
     ]
 }`, senderWallet.Address)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddRemoveValidatorAction()
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -171,7 +209,7 @@ func TestAddTransferAssetAction(t *testing.T) {
 	fromAccountHash := "FAccH1"
 	toAccountHash := "TAccH1"
 	assetHash := "AssetH1"
-	var amount float64 = 100
+	amount := MustParseAmount("100")
 
 	expectedJson :=
 		fmt.Sprintf(
@@ -187,13 +225,13 @@ func TestAddTransferAssetAction(t *testing.T) {
                 "fromAccountHash": "%s",
                 "toAccountHash": "%s",
                 "assetHash": "%s",
-                "amount": %3.0f
+                "amount": %s
             }
         }
     ]
 }`, senderWallet.Address, fromAccountHash, toAccountHash, assetHash, amount)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddTransferAssetAction(fromAccountHash, toAccountHash, assetHash, amount)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -203,7 +241,7 @@ func TestAddCreateAssetEmissionAction(t *testing.T) {
 	senderWallet := GenerateWallet()
 	emissionAccountHash := "EAccH1"
 	assetHash := "AssetH1"
-	var amount float64 = 10000
+	amount := MustParseAmount("10000")
 
 	expectedJson :=
 		fmt.Sprintf(
@@ -218,13 +256,13 @@ func TestAddCreateAssetEmissionAction(t *testing.T) {
             "actionData": {
                 "emissionAccountHash": "%s",
                 "assetHash": "%s",
-                "amount": %3.0f
+                "amount": %s
             }
         }
     ]
 }`, senderWallet.Address, emissionAccountHash, assetHash, amount)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddCreateAssetEmissionAction(emissionAccountHash, assetHash, amount)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -248,7 +286,7 @@ func TestAddCreateAssetAction(t *testing.T) {
     ]
 }`, senderWallet.Address)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddCreateAssetAction()
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -259,7 +297,7 @@ func TestAddCreateAssetActionReturnsAssetHash(t *testing.T) {
 	var nonce int64 = 1
 	expectedHash := DeriveHash(senderWallet.Address, nonce, 1)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	actualHash := tx.AddCreateAssetAction()
 	assert.Equal(t, expectedHash, actualHash)
 }
@@ -287,7 +325,7 @@ func TestAddSetAssetCodeAction(t *testing.T) {
     ]
 }`, senderWallet.Address, assetHash, assetCode)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddSetAssetCodeAction(assetHash, assetCode)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -316,7 +354,7 @@ func TestAddSetAssetControllerAction(t *testing.T) {
     ]
 }`, senderWallet.Address, assetHash, controllerWallet.Address)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddSetAssetControllerAction(assetHash, controllerWallet.Address)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -326,12 +364,36 @@ func TestAddSetAssetControllerAction(t *testing.T) {
 // Actions: Account Management
 ////////////////////////////////////////////////////////////////////////////////////////////////////
 
+func TestAddCreateAccountAction(t *testing.T) {
+	senderWallet := GenerateWallet()
+
+	expectedJson :=
+		fmt.Sprintf(
+			`{
+    "senderAddress": "%s",
+    "nonce": 1,
+    "expirationTime": 0,
+    "actionFee": 0.01,
+    "actions": [
+        {
+            "actionType": "CreateAccount",
+            "actionData": {}
+        }
+    ]
+}`, senderWallet.Address)
+
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddCreateAccountAction()
+	actualJson := tx.ToJson(true)
+	assert.Equal(t, expectedJson, actualJson)
+}
+
 func TestAddCreateAccountActionReturnsAccountHash(t *testing.T) {
 	senderWallet := GenerateWallet()
 	var nonce int64 = 1
 	expectedHash := DeriveHash(senderWallet.Address, nonce, 1)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	actualHash := tx.AddCreateAccountAction()
 	assert.Equal(t, expectedHash, actualHash)
 }
@@ -359,7 +421,7 @@ func TestAddSetAccountControllerAction(t *testing.T) {
     ]
 }`, senderWallet.Address, accountHash, controllerWallet.Address)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddSetAccountControllerAction(accountHash, controllerWallet.Address)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -396,7 +458,7 @@ func TestAddSubmitVoteAction(t *testing.T) {
     ]
 }`, senderWallet.Address, accountHash, assetHash, resolutionHash, voteHash)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddSubmitVoteAction(accountHash, assetHash, resolutionHash, voteHash)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -407,7 +469,7 @@ func TestAddSubmitVoteWeightAction(t *testing.T) {
 	accountHash := "AccountH1"
 	assetHash := "AssetH1"
 	resolutionHash := "ResolutionH1"
-	var voteWeight float64 = 12345
+	voteWeight := MustParseAmount("12345")
 
 	expectedJson :=
 		fmt.Sprintf(
@@ -423,13 +485,13 @@ func TestAddSubmitVoteWeightAction(t *testing.T) {
                 "accountHash": "%s",
                 "assetHash": "%s",
                 "resolutionHash": "%s",
-                "voteWeight": %5.0f
+                "voteWeight": %s
             }
         }
     ]
 }`, senderWallet.Address, accountHash, assetHash, resolutionHash, voteWeight)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddSubmitVoteWeightAction(accountHash, assetHash, resolutionHash, voteWeight)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -466,7 +528,7 @@ func TestAddSetAccountEligibilityAction(t *testing.T) {
     ]
 }`, senderWallet.Address, accountHash, assetHash, isPrimaryEligible, isSecondaryEligible)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddSetAccountEligibilityAction(accountHash, assetHash, isPrimaryEligible, isSecondaryEligible)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -495,7 +557,7 @@ func TestAddSetAssetEligibilityAction(t *testing.T) {
     ]
 }`, senderWallet.Address, assetHash, isEligibilityRequired)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddSetAssetEligibilityAction(assetHash, isEligibilityRequired)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -526,7 +588,7 @@ func TestAddChangeKycControllerAddressAction(t *testing.T) {
     ]
 }`, senderWallet.Address, accountHash, assetHash, kycControllerAddress)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddChangeKycControllerAddressAction(accountHash, assetHash, kycControllerAddress)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -555,7 +617,7 @@ func TestAddAddKycProviderAction(t *testing.T) {
     ]
 }`, senderWallet.Address, assetHash, providerAddress)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddAddKycProviderAction(assetHash, providerAddress)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -584,7 +646,7 @@ func TestAddRemoveKycProviderAction(t *testing.T) {
     ]
 }`, senderWallet.Address, assetHash, providerAddress)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddRemoveKycProviderAction(assetHash, providerAddress)
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
@@ -598,8 +660,8 @@ func TestAddTransferChxActionMultiple(t *testing.T) {
 	senderWallet := GenerateWallet()
 	recipientWallet1 := GenerateWallet()
 	recipientWallet2 := GenerateWallet()
-	var amount1 float64 = 200
-	var amount2 float64 = 300
+	amount1 := MustParseAmount("200")
+	amount2 := MustParseAmount("300")
 
 	expectedJson :=
 		fmt.Sprintf(
@@ -613,23 +675,189 @@ func TestAddTransferChxActionMultiple(t *testing.T) {
             "actionType": "TransferChx",
             "actionData": {
                 "recipientAddress": "%s",
-                "amount": %3.0f
+                "amount": %s
             }
         },
         {
             "actionType": "TransferChx",
             "actionData": {
                 "recipientAddress": "%s",
-                "amount": %3.0f
+                "amount": %s
             }
         }
     ]
 }`, senderWallet.Address, recipientWallet1.Address, amount1, recipientWallet2.Address, amount2)
 
-	tx := CreateTx(senderWallet.Address, 1, 0.01, 0)
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
 	tx.AddTransferChxAction(recipientWallet1.Address, amount1)
 	tx.AddTransferChxAction(recipientWallet2.Address, amount2)
 
 	actualJson := tx.ToJson(true)
 	assert.Equal(t, expectedJson, actualJson)
 }
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Signing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestTrySignInvalidPrivateKey(t *testing.T) {
+	senderWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+
+	signedTx, err := tx.TrySign("UNIT_TESTS", "0OIl")
+
+	assert.Nil(t, signedTx)
+	assert.ErrorIs(t, err, ErrInvalidPrivateKey)
+}
+
+func TestSignInvalidPrivateKeyReturnsUnsignedTx(t *testing.T) {
+	senderWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+
+	signedTx := tx.Sign("UNIT_TESTS", "0OIl")
+
+	assert.NotNil(t, signedTx)
+	assert.Equal(t, Encode64([]byte(tx.ToJson(false))), signedTx.Tx)
+	assert.Empty(t, signedTx.Signature)
+}
+
+func TestTrySignMatchesSign(t *testing.T) {
+	senderWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("10"))
+
+	signedTx, err := tx.TrySign("UNIT_TESTS", senderWallet.PrivateKey)
+
+	assert.NoError(t, err)
+	assert.Equal(t, tx.Sign("UNIT_TESTS", senderWallet.PrivateKey), signedTx)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Parsing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestParseTxRestoresTypedActions(t *testing.T) {
+	senderWallet := GenerateWallet()
+	recipientWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 3, MustParseAmount("0.01"), 123)
+	tx.AddTransferChxAction(recipientWallet.Address, MustParseAmount("1.5"))
+	assetHash := tx.AddCreateAssetAction()
+	tx.AddSetAssetCodeAction(assetHash, "AST1")
+	tx.AddConfigureValidatorAction("val01.some.domain.com:25718", 20, true)
+
+	parsedTx, err := ParseTx(tx.ToJson(false))
+
+	assert.NoError(t, err)
+	assert.Equal(t, tx, parsedTx)
+	assert.IsType(t, TransferChxTxActionDto{}, parsedTx.Actions[0].ActionData)
+}
+
+func TestParseTxUnknownActionType(t *testing.T) {
+	txJson := `{"senderAddress":"CHxxx","nonce":1,"expirationTime":0,"actionFee":0.01,"actions":[{"actionType":"Unknown","actionData":{}}]}`
+
+	tx, err := ParseTx(txJson)
+
+	assert.Nil(t, tx)
+	assert.ErrorIs(t, err, ErrUnknownActionType)
+}
+
+func TestParseSignedTxInvalidJson(t *testing.T) {
+	inlineData := []string{
+		"",
+		"{",
+		`{"tx":"eyJ9"}`,
+		`{"signature":"Sig1"}`,
+	}
+
+	for _, signedTxJson := range inlineData {
+		signedTx, err := ParseSignedTx(signedTxJson)
+		assert.Nil(t, signedTx)
+		assert.ErrorIs(t, err, ErrInvalidTx)
+	}
+}
+
+func TestSignedTxVerify(t *testing.T) {
+	senderWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("100"))
+	signedTxJson := tx.Sign("UNIT_TESTS", senderWallet.PrivateKey).ToJson(false)
+
+	signedTx, err := ParseSignedTx(signedTxJson)
+	assert.NoError(t, err)
+	verifiedTx, err := signedTx.Verify("UNIT_TESTS")
+
+	assert.NoError(t, err)
+	assert.Equal(t, tx, verifiedTx)
+}
+
+func TestSignedTxVerifyWrongNetwork(t *testing.T) {
+	senderWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	signedTx := tx.Sign("UNIT_TESTS", senderWallet.PrivateKey)
+
+	verifiedTx, err := signedTx.Verify("OWN_PUBLIC_BLOCKCHAIN_MAINNET")
+
+	assert.Nil(t, verifiedTx)
+	assert.ErrorIs(t, err, ErrSignerMismatch)
+}
+
+func TestSignedTxVerifyNotSignedBySender(t *testing.T) {
+	senderWallet := GenerateWallet()
+	otherWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	signedTx := tx.Sign("UNIT_TESTS", otherWallet.PrivateKey)
+
+	verifiedTx, err := signedTx.Verify("UNIT_TESTS")
+
+	assert.Nil(t, verifiedTx)
+	assert.ErrorIs(t, err, ErrSignerMismatch)
+}
+
+func TestSignedTxVerifyTamperedTx(t *testing.T) {
+	senderWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("1"))
+	signedTx := tx.Sign("UNIT_TESTS", senderWallet.PrivateKey)
+
+	tx.Actions[0].ActionData = TransferChxTxActionDto{
+		RecipientAddress: GenerateWallet().Address,
+		Amount:           MustParseAmount("1000"),
+	}
+	signedTx.Tx = Encode64([]byte(tx.ToJson(false)))
+	verifiedTx, err := signedTx.Verify("UNIT_TESTS")
+
+	assert.Nil(t, verifiedTx)
+	assert.ErrorIs(t, err, ErrSignerMismatch)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Hashing
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func TestTxHash(t *testing.T) {
+	tx := CreateTx("CHPJ6aVwpGBRf1dv6Ey1TuhJzt1VtCP5LYB", 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction("CHb5Z6Za34nv28Z3rLZ2Yd8LFikHaTqLhxB", MustParseAmount("100"))
+	expectedHash := Hash([]byte(`{"senderAddress":"CHPJ6aVwpGBRf1dv6Ey1TuhJzt1VtCP5LYB","nonce":1,"expirationTime":0,"actionFee":0.01,"actions":[{"actionType":"TransferChx","actionData":{"recipientAddress":"CHb5Z6Za34nv28Z3rLZ2Yd8LFikHaTqLhxB","amount":100}}]}`))
+
+	assert.Equal(t, "7xP4LaFycdbmSvxufLY3JCfNi6nV8noExPQFFcf5i51z", expectedHash)
+	assert.Equal(t, expectedHash, tx.TxHash())
+}
+
+func TestSignedTxHashMatchesTxHash(t *testing.T) {
+	senderWallet := GenerateWallet()
+	tx := CreateTx(senderWallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("100"))
+	signedTx := tx.Sign("UNIT_TESTS", senderWallet.PrivateKey)
+
+	assert.Equal(t, tx.TxHash(), signedTx.TxHash())
+}
+
+func TestSignedTxHashInvalidTx(t *testing.T) {
+	signedTx := &SignedTx{Tx: "not base64!", Signature: "Sig1"}
+
+	txHash, err := signedTx.TryTxHash()
+
+	assert.Equal(t, "", txHash)
+	assert.ErrorIs(t, err, ErrInvalidTx)
+	assert.Equal(t, "", signedTx.TxHash())
+}
@@ -0,0 +1,235 @@
+package ownSdk
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DefaultMinActionFee is the minimum action fee accepted by nodes with the default configuration.
+// Use Client.GetMinActionFee to get the actual value of a particular node.
+var DefaultMinActionFee = Amount{units: 10000} // 0.001
+
+var assetCodePattern = regexp.MustCompile(`^[A-Z0-9]{1,20}$`)
+
+// TxFieldError is a single problem found by Tx.Validate. ActionIndex is the zero-based index of
+// the offending action, or -1 for fields of the transaction itself.
+type TxFieldError struct {
+	ActionIndex int
+	ActionType  ActionType
+	Field       string
+	Message     string
+}
+
+func (e TxFieldError) Error() string {
+	if e.ActionIndex < 0 {
+		return fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	if e.Field == "" {
+		return fmt.Sprintf("actions[%d] (%s): %s", e.ActionIndex, e.ActionType, e.Message)
+	}
+	return fmt.Sprintf("actions[%d] (%s).%s: %s", e.ActionIndex, e.ActionType, e.Field, e.Message)
+}
+
+// TxValidationError lists all problems found by Tx.Validate. It matches ErrInvalidTx.
+type TxValidationError struct {
+	Errors []TxFieldError
+}
+
+func (e *TxValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fieldError := range e.Errors {
+		messages[i] = fieldError.Error()
+	}
+	return fmt.Sprintf("%v: %s", ErrInvalidTx, strings.Join(messages, "; "))
+}
+
+func (e *TxValidationError) Unwrap() error {
+	return ErrInvalidTx
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Validation
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func IsValidHash(hash string) bool {
+	hashBytes, err := TryDecode58(hash)
+	return err == nil && len(hashBytes) == 32
+}
+
+type txValidator struct {
+	errors      []TxFieldError
+	actionIndex int
+	actionType  ActionType
+}
+
+func (v *txValidator) fail(field string, message string) {
+	v.errors = append(v.errors, TxFieldError{
+		ActionIndex: v.actionIndex,
+		ActionType:  v.actionType,
+		Field:       field,
+		Message:     message,
+	})
+}
+
+func (v *txValidator) address(field string, address string) {
+	if !IsValidBlockchainAddress(address) {
+		v.fail(field, fmt.Sprintf("invalid blockchain address %q", address))
+	}
+}
+
+func (v *txValidator) hash(field string, hash string) {
+	if !IsValidHash(hash) {
+		v.fail(field, fmt.Sprintf("invalid hash %q", hash))
+	}
+}
+
+func (v *txValidator) notEmpty(field string, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.fail(field, "must not be empty")
+	}
+}
+
+func (v *txValidator) positive(field string, amount Amount) {
+	if !amount.IsPositive() {
+		v.fail(field, fmt.Sprintf("must be greater than zero, got %s", amount))
+	}
+}
+
+func (v *txValidator) action(actionData interface{}) {
+	value := reflect.ValueOf(actionData)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		actionData = value.Elem().Interface()
+	}
+
+	switch dto := actionData.(type) {
+	case TransferChxTxActionDto:
+		v.address("recipientAddress", dto.RecipientAddress)
+		v.positive("amount", dto.Amount)
+	case DelegateStakeTxActionDto:
+		v.address("validatorAddress", dto.ValidatorAddress)
+		if dto.Amount.IsZero() {
+			v.fail("amount", "must not be zero")
+		}
+	case ConfigureValidatorTxActionDto:
+		v.notEmpty("networkAddress", dto.NetworkAddress)
+		if dto.SharedRewardPercent < 0 || dto.SharedRewardPercent > 100 {
+			v.fail("sharedRewardPercent", fmt.Sprintf("must be between 0 and 100, got %v", dto.SharedRewardPercent))
+		} else if math.Round(dto.SharedRewardPercent*100) != dto.SharedRewardPercent*100 {
+			v.fail("sharedRewardPercent", fmt.Sprintf("must not have more than 2 decimal places, got %v", dto.SharedRewardPercent))
+		}
+	case RemoveValidatorTxActionDto:
+	case TransferAssetTxActionDto:
+		v.hash("fromAccountHash", dto.FromAccountHash)
+		v.hash("toAccountHash", dto.ToAccountHash)
+		if dto.FromAccountHash == dto.ToAccountHash {
+			v.fail("toAccountHash", "must differ from fromAccountHash")
+		}
+		v.hash("assetHash", dto.AssetHash)
+		v.positive("amount", dto.Amount)
+	case CreateAssetEmissionTxActionDto:
+		v.hash("emissionAccountHash", dto.EmissionAccountHash)
+		v.hash("assetHash", dto.AssetHash)
+		v.positive("amount", dto.Amount)
+	case CreateAssetTxActionDto:
+	case SetAssetCodeTxActionDto:
+		v.hash("assetHash", dto.AssetHash)
+		if !assetCodePattern.MatchString(dto.AssetCode) {
+			v.fail("assetCode", fmt.Sprintf("must be 1 to 20 uppercase letters or digits, got %q", dto.AssetCode))
+		}
+	case SetAssetControllerTxActionDto:
+		v.hash("assetHash", dto.AssetHash)
+		v.address("controllerAddress", dto.ControllerAddress)
+	case CreateAccountTxActionDto:
+	case SetAccountControllerTxActionDto:
+		v.hash("accountHash", dto.AccountHash)
+		v.address("controllerAddress", dto.ControllerAddress)
+	case SubmitVoteTxActionDto:
+		v.hash("accountHash", dto.AccountHash)
+		v.hash("assetHash", dto.AssetHash)
+		v.notEmpty("resolutionHash", dto.ResolutionHash)
+		v.notEmpty("voteHash", dto.VoteHash)
+	case SubmitVoteWeightTxActionDto:
+		v.hash("accountHash", dto.AccountHash)
+		v.hash("assetHash", dto.AssetHash)
+		v.notEmpty("resolutionHash", dto.ResolutionHash)
+		if dto.VoteWeight.IsNegative() {
+			v.fail("voteWeight", fmt.Sprintf("must not be negative, got %s", dto.VoteWeight))
+		}
+	case SetAccountEligibilityTxActionDto:
+		v.hash("accountHash", dto.AccountHash)
+		v.hash("assetHash", dto.AssetHash)
+	case SetAssetEligibilityTxActionDto:
+		v.hash("assetHash", dto.AssetHash)
+	case ChangeKycControllerAddressTxActionDto:
+		v.hash("accountHash", dto.AccountHash)
+		v.hash("assetHash", dto.AssetHash)
+		v.address("kycControllerAddress", dto.KycControllerAddress)
+	case AddKycProviderTxActionDto:
+		v.hash("assetHash", dto.AssetHash)
+		v.address("providerAddress", dto.ProviderAddress)
+	case RemoveKycProviderTxActionDto:
+		v.hash("assetHash", dto.AssetHash)
+		v.address("providerAddress", dto.ProviderAddress)
+	case ValidatableActionData:
+		if err := dto.Validate(); err != nil {
+			v.fail("", err.Error())
+		}
+	default:
+		// Action types registered outside of the SDK have no checks of their own.
+	}
+}
+
+// ValidateWithMinActionFee checks the transaction against the node's validation rules and
+// returns a *TxValidationError listing every problem, or nil.
+func (tx *Tx) ValidateWithMinActionFee(minActionFee Amount) error {
+	v := &txValidator{actionIndex: -1}
+
+	v.address("senderAddress", tx.SenderAddress)
+	if tx.Nonce <= 0 {
+		v.fail("nonce", fmt.Sprintf("must be greater than zero, got %d", tx.Nonce))
+	}
+	if tx.ExpirationTime < 0 {
+		v.fail("expirationTime", fmt.Sprintf("must not be negative, got %d", tx.ExpirationTime))
+	}
+	if tx.ActionFee.Cmp(minActionFee) < 0 {
+		v.fail("actionFee", fmt.Sprintf("must be at least %s, got %s", minActionFee, tx.ActionFee))
+	}
+	if len(tx.Actions) == 0 {
+		v.fail("actions", "must contain at least one action")
+	}
+
+	for i, action := range tx.Actions {
+		v.actionIndex = i
+		v.actionType = action.ActionType
+		if !IsRegisteredActionType(action.ActionType) {
+			v.fail("", fmt.Sprintf("unknown action type %q", action.ActionType))
+			continue
+		}
+		if action.ActionData == nil {
+			v.fail("actionData", "is required")
+			continue
+		}
+		if dataType := action.ActionData.ActionType(); dataType != action.ActionType {
+			v.fail("actionData", fmt.Sprintf("expected %s data, got %s data", action.ActionType, dataType))
+			continue
+		}
+		v.action(action.ActionData)
+	}
+
+	if len(v.errors) > 0 {
+		return &TxValidationError{Errors: v.errors}
+	}
+	return nil
+}
+
+// Validate checks the transaction against the node's validation rules, using DefaultMinActionFee.
+func (tx *Tx) Validate() error {
+	return tx.ValidateWithMinActionFee(DefaultMinActionFee)
+}
@@ -0,0 +1,115 @@
+package ownSdk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validationErrors(t *testing.T, err error) []TxFieldError {
+	var validationErr *TxValidationError
+	if !assert.True(t, errors.As(err, &validationErr), "%v", err) {
+		return nil
+	}
+	assert.ErrorIs(t, err, ErrInvalidTx)
+	return validationErr.Errors
+}
+
+func TestTxValidateValidTx(t *testing.T) {
+	wallet := GenerateWallet()
+	recipient := GenerateWallet().Address
+
+	tx := CreateTx(wallet.Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction(recipient, MustParseAmount("100"))
+	tx.AddDelegateStakeAction(recipient, MustParseAmount("-5"))
+	tx.AddConfigureValidatorAction("val01.example.com:25718", 12.5, true)
+	assetHash := tx.AddCreateAssetAction()
+	accountHash := tx.AddCreateAccountAction()
+	otherAccountHash := DeriveHash(recipient, 1, 1)
+	tx.AddSetAssetCodeAction(assetHash, "OWN1")
+	tx.AddCreateAssetEmissionAction(accountHash, assetHash, MustParseAmount("1000"))
+	tx.AddTransferAssetAction(accountHash, otherAccountHash, assetHash, MustParseAmount("0.5"))
+	tx.AddSubmitVoteAction(accountHash, assetHash, "RSH1", "Yes")
+	tx.AddSubmitVoteWeightAction(accountHash, assetHash, "RSH1", MustParseAmount("0"))
+	tx.AddAddKycProviderAction(assetHash, recipient)
+
+	assert.NoError(t, tx.Validate())
+}
+
+func TestTxValidateTxFields(t *testing.T) {
+	tx := CreateTx("CHxxx", 0, MustParseAmount("0.0001"), -1)
+
+	errs := validationErrors(t, tx.Validate())
+	fields := make([]string, 0)
+	for _, fieldErr := range errs {
+		assert.Equal(t, -1, fieldErr.ActionIndex)
+		fields = append(fields, fieldErr.Field)
+	}
+	assert.Equal(t, []string{"senderAddress", "nonce", "expirationTime", "actionFee", "actions"}, fields)
+
+	tx = CreateTx(GenerateWallet().Address, 1, MustParseAmount("0.0001"), 0)
+	tx.AddRemoveValidatorAction()
+	assert.NoError(t, tx.ValidateWithMinActionFee(MustParseAmount("0.0001")))
+}
+
+func TestTxValidateActions(t *testing.T) {
+	sender := GenerateWallet().Address
+	tx := CreateTx(sender, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction("CHinvalid", MustParseAmount("-1"))
+	tx.AddDelegateStakeAction(sender, MustParseAmount("0"))
+	tx.AddConfigureValidatorAction("", 100.5, true)
+	tx.AddSetAssetCodeAction("", "own-1")
+	tx.AddTransferAssetAction("AH", "AH", DeriveHash(sender, 1, 1), MustParseAmount("1"))
+	tx.Actions = append(tx.Actions, TxAction{ActionType: "MintChx", ActionData: RawActionData{Type: "MintChx"}})
+	tx.Actions = append(tx.Actions, TxAction{ActionType: "TransferChx", ActionData: DelegateStakeTxActionDto{}})
+	tx.Actions = append(tx.Actions, TxAction{ActionType: "TransferChx"})
+
+	errs := validationErrors(t, tx.Validate())
+
+	type location struct {
+		ActionIndex int
+		Field       string
+	}
+	locations := make([]location, 0)
+	for _, fieldErr := range errs {
+		locations = append(locations, location{fieldErr.ActionIndex, fieldErr.Field})
+	}
+	assert.Equal(t, []location{
+		{0, "recipientAddress"},
+		{0, "amount"},
+		{1, "amount"},
+		{2, "networkAddress"},
+		{2, "sharedRewardPercent"},
+		{3, "assetHash"},
+		{3, "assetCode"},
+		{4, "fromAccountHash"},
+		{4, "toAccountHash"},
+		{4, "toAccountHash"},
+		{5, ""},
+		{6, "actionData"},
+		{7, "actionData"},
+	}, locations)
+
+	assert.Equal(t, `actions[0] (TransferChx).recipientAddress: invalid blockchain address "CHinvalid"`, errs[0].Error())
+	assert.Contains(t, tx.Validate().Error(), `actions[5] (MintChx): unknown action type "MintChx"`)
+	assert.Equal(t, "expected TransferChx data, got DelegateStake data", errs[11].Message)
+}
+
+func TestTxValidateSharedRewardPercentPrecision(t *testing.T) {
+	tx := CreateTx(GenerateWallet().Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddConfigureValidatorAction("val01:25718", 10.125, true)
+
+	errs := validationErrors(t, tx.Validate())
+	assert.Len(t, errs, 1)
+	assert.Equal(t, "sharedRewardPercent", errs[0].Field)
+}
+
+func TestTxValidateParsedTx(t *testing.T) {
+	tx := CreateTx(GenerateWallet().Address, 1, MustParseAmount("0.01"), 0)
+	tx.AddTransferChxAction(GenerateWallet().Address, MustParseAmount("1"))
+
+	parsedTx, err := ParseTx(tx.ToJson(false))
+	assert.NoError(t, err)
+	assert.NoError(t, parsedTx.Validate())
+}
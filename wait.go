@@ -0,0 +1,146 @@
+package ownSdk
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type TxStatus string
+
+const (
+	TxStatusPending TxStatus = "Pending"
+	TxStatusSuccess TxStatus = "Success"
+	TxStatusFailed  TxStatus = "Failure"
+)
+
+type TxResult struct {
+	TxHash             string
+	Status             TxStatus
+	BlockNumber        int64
+	ErrorCode          int
+	FailedActionNumber int
+}
+
+type WaitOptions struct {
+	PollInterval    time.Duration // First delay between polls. Defaults to 1s.
+	MaxPollInterval time.Duration // Upper bound for the backoff. Defaults to 10s.
+	BackoffFactor   float64       // Defaults to 1.5.
+
+	// ExpirationTime is Tx.ExpirationTime (Unix milliseconds, 0 if the tx never expires).
+	// Once it has passed by more than ExpirationGrace, a tx which is still pending or unknown
+	// can't be included anymore, so waiting stops with ErrTxExpired.
+	ExpirationTime  int64
+	ExpirationGrace time.Duration // Defaults to 30s.
+}
+
+var ErrTxExpired = errors.New("transaction expired")
+
+var DefaultWaitOptions = WaitOptions{
+	PollInterval:    time.Second,
+	MaxPollInterval: 10 * time.Second,
+	BackoffFactor:   1.5,
+	ExpirationGrace: 30 * time.Second,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////////////////
+// Waiting
+////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (o *WaitOptions) withDefaults() WaitOptions {
+	options := DefaultWaitOptions
+	if o == nil {
+		return options
+	}
+	options.ExpirationTime = o.ExpirationTime
+	if o.PollInterval > 0 {
+		options.PollInterval = o.PollInterval
+	}
+	if o.MaxPollInterval > 0 {
+		options.MaxPollInterval = o.MaxPollInterval
+	}
+	if o.BackoffFactor >= 1 {
+		options.BackoffFactor = o.BackoffFactor
+	}
+	if o.ExpirationGrace > 0 {
+		options.ExpirationGrace = o.ExpirationGrace
+	}
+	return options
+}
+
+func txResultFromTxInfo(txHash string, txInfo *TxInfoDto) *TxResult {
+	result := &TxResult{TxHash: txHash, Status: TxStatusPending}
+	if txInfo == nil {
+		return result
+	}
+
+	result.Status = txInfo.Status
+	if txInfo.IncludedInBlockNumber != nil {
+		result.BlockNumber = *txInfo.IncludedInBlockNumber
+	}
+	if txInfo.ErrorCode != nil {
+		result.ErrorCode = *txInfo.ErrorCode
+	}
+	if txInfo.FailedActionNumber != nil {
+		result.FailedActionNumber = *txInfo.FailedActionNumber
+	}
+	return result
+}
+
+// WaitForTx polls the node until the transaction succeeds or fails. A tx which is not known
+// to the node yet is treated as pending. If the context is done or the tx expires first,
+// the last observed result is returned together with the error.
+func (c *Client) WaitForTx(ctx context.Context, txHash string, opts *WaitOptions) (*TxResult, error) {
+	options := opts.withDefaults()
+
+	var deadline time.Time
+	if options.ExpirationTime > 0 {
+		deadline = time.UnixMilli(options.ExpirationTime).Add(options.ExpirationGrace)
+	}
+
+	result := txResultFromTxInfo(txHash, nil)
+	interval := options.PollInterval
+	for {
+		txInfo, err := c.GetTx(ctx, txHash)
+		if err != nil && !IsNotFoundError(err) {
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			return result, err
+		}
+		if err == nil {
+			result = txResultFromTxInfo(txHash, txInfo)
+			if result.Status != TxStatusPending {
+				return result, nil
+			}
+		}
+
+		delay := interval
+		if !deadline.IsZero() {
+			untilDeadline := time.Until(deadline)
+			if untilDeadline <= 0 {
+				return result, ErrTxExpired
+			}
+			if untilDeadline < delay {
+				delay = untilDeadline
+			}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * options.BackoffFactor)
+		if interval > options.MaxPollInterval {
+			interval = options.MaxPollInterval
+		}
+	}
+}
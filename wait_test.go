@@ -0,0 +1,136 @@
+package ownSdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fastWaitOptions = &WaitOptions{
+	PollInterval:    time.Millisecond,
+	MaxPollInterval: 5 * time.Millisecond,
+	BackoffFactor:   2,
+}
+
+func newSequenceNode(t *testing.T, responses ...string) (*Client, func() int) {
+	var mutex sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		response := responses[len(responses)-1]
+		if calls < len(responses) {
+			response = responses[calls]
+		}
+		calls++
+
+		if response == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	callCount := func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return calls
+	}
+	return NewClient(server.URL), callCount
+}
+
+func TestWaitForTxSuccess(t *testing.T) {
+	client, calls := newSequenceNode(t,
+		"",
+		`{"txHash": "TxH1", "status": "Pending"}`,
+		`{"txHash": "TxH1", "status": "Success", "includedInBlockNumber": 42}`,
+	)
+
+	result, err := client.WaitForTx(context.Background(), "TxH1", fastWaitOptions)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &TxResult{TxHash: "TxH1", Status: TxStatusSuccess, BlockNumber: 42}, result)
+	assert.Equal(t, 3, calls())
+}
+
+func TestWaitForTxFailure(t *testing.T) {
+	client, _ := newSequenceNode(t,
+		`{"txHash": "TxH1", "status": "Failure", "errorCode": 210, "failedActionNumber": 2, "includedInBlockNumber": 7}`,
+	)
+
+	result, err := client.WaitForTx(context.Background(), "TxH1", fastWaitOptions)
+
+	assert.NoError(t, err)
+	assert.Equal(t, TxStatusFailed, result.Status)
+	assert.Equal(t, int64(7), result.BlockNumber)
+	assert.Equal(t, 210, result.ErrorCode)
+	assert.Equal(t, 2, result.FailedActionNumber)
+}
+
+func TestWaitForTxContextCancellation(t *testing.T) {
+	client, _ := newSequenceNode(t, `{"txHash": "TxH1", "status": "Pending"}`)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	result, err := client.WaitForTx(ctx, "TxH1", fastWaitOptions)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, TxStatusPending, result.Status)
+}
+
+func TestWaitForTxExpiration(t *testing.T) {
+	client, calls := newSequenceNode(t, `{"txHash": "TxH1", "status": "Pending"}`)
+	options := *fastWaitOptions
+	options.ExpirationTime = time.Now().Add(10 * time.Millisecond).UnixMilli()
+	options.ExpirationGrace = 10 * time.Millisecond
+
+	start := time.Now()
+	result, err := client.WaitForTx(context.Background(), "TxH1", &options)
+
+	assert.ErrorIs(t, err, ErrTxExpired)
+	assert.Equal(t, TxStatusPending, result.Status)
+	assert.True(t, time.Since(start) < time.Second)
+	assert.True(t, calls() > 1)
+}
+
+func TestWaitForTxAlreadyExpired(t *testing.T) {
+	client, calls := newSequenceNode(t, "")
+	options := &WaitOptions{ExpirationTime: 1}
+
+	result, err := client.WaitForTx(context.Background(), "TxH1", options)
+
+	assert.ErrorIs(t, err, ErrTxExpired)
+	assert.Equal(t, TxStatusPending, result.Status)
+	assert.Equal(t, 1, calls())
+}
+
+func TestWaitForTxExpiredButProcessed(t *testing.T) {
+	client, _ := newSequenceNode(t, `{"txHash": "TxH1", "status": "Success", "includedInBlockNumber": 3}`)
+	options := &WaitOptions{ExpirationTime: 1}
+
+	result, err := client.WaitForTx(context.Background(), "TxH1", options)
+
+	assert.NoError(t, err)
+	assert.Equal(t, TxStatusSuccess, result.Status)
+}
+
+func TestWaitForTxApiError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL).WaitForTx(context.Background(), "TxH1", fastWaitOptions)
+
+	apiErr, ok := err.(*ApiError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+}